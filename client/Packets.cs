@@ -30,56 +30,177 @@ namespace Client.Packets {
             "CSJLChZSZWdpc3RlclJlcXVlc3RNZXNzYWdlEhAKCHVzZXJuYW1lGAEgASgJ",
             "EhAKCHBhc3N3b3JkGAIgASgJEg0KBWNvbG9yGAMgASgNIhMKEU9rUmVzcG9u",
             "c2VNZXNzYWdlIiIKE0RlbnlSZXNwb25zZU1lc3NhZ2USCwoDbXNnGAEgASgJ",
-            "IoABCg1QbGF5ZXJNZXNzYWdlEgoKAmlkGAEgASgEEgwKBG5hbWUYAiABKAkS",
+            "Is8BCg1QbGF5ZXJNZXNzYWdlEgoKAmlkGAEgASgEEgwKBG5hbWUYAiABKAkS",
             "CQoBeBgDIAEoARIJCgF5GAQgASgBEg4KBnJhZGl1cxgFIAEoARIRCglkaXJl",
-            "Y3Rpb24YBiABKAESDQoFc3BlZWQYByABKAESDQoFY29sb3IYCCABKA0iKwoW",
-            "UGxheWVyRGlyZWN0aW9uTWVzc2FnZRIRCglkaXJlY3Rpb24YAiABKAEiQAoM",
-            "U3BvcmVNZXNzYWdlEgoKAmlkGAEgASgEEgkKAXgYAiABKAESCQoBeRgDIAEo",
-            "ARIOCgZyYWRpdXMYBCABKAEiKAoUU3BvcmVDb25zdW1lZE1lc3NhZ2USEAoI",
-            "c3BvcmVfaWQYASABKAQiOgoRU3BvcmVCYXRjaE1lc3NhZ2USJQoGc3BvcmVz",
-            "GAEgAygLMhUucGFja2V0cy5TcG9yZU1lc3NhZ2UiKgoVUGxheWVyQ29uc3Vt",
-            "ZWRNZXNzYWdlEhEKCXBsYXllcl9pZBgBIAEoBCIcChpIaXNjb3JlQm9hcmRS",
-            "ZXF1ZXN0TWVzc2FnZSI7Cg5IaXNjb3JlTWVzc2FnZRIMCgRyYW5rGAEgASgE",
-            "EgwKBG5hbWUYAiABKAkSDQoFc2NvcmUYAyABKAQiQAoTSGlzY29yZUJvYXJk",
-            "TWVzc2FnZRIpCghoaXNjb3JlcxgBIAMoCzIXLnBhY2tldHMuSGlzY29yZU1l",
-            "c3NhZ2UiIQofRmluaXNoZWRCcm93c2luZ0hpc2NvcmVzTWVzc2FnZSIkChRT",
-            "ZWFyY2hIaXNjb3JlTWVzc2FnZRIMCgRuYW1lGAEgASgJIiMKEURpc2Nvbm5l",
-            "Y3RNZXNzYWdlEg4KBnJlYXNvbhgBIAEoCSLgBwoGUGFja2V0EhEKCXNlbmRl",
-            "cl9pZBgBIAEoBBIkCgRjaGF0GAIgASgLMhQucGFja2V0cy5DaGF0TWVzc2Fn",
-            "ZUgAEiAKAmlkGAMgASgLMhIucGFja2V0cy5JZE1lc3NhZ2VIABI1Cg1sb2dp",
-            "bl9yZXF1ZXN0GAQgASgLMhwucGFja2V0cy5Mb2dpblJlcXVlc3RNZXNzYWdl",
-            "SAASOwoQcmVnaXN0ZXJfcmVxdWVzdBgFIAEoCzIfLnBhY2tldHMuUmVnaXN0",
-            "ZXJSZXF1ZXN0TWVzc2FnZUgAEjEKC29rX3Jlc3BvbnNlGAYgASgLMhoucGFj",
-            "a2V0cy5Pa1Jlc3BvbnNlTWVzc2FnZUgAEjUKDWRlbnlfcmVzcG9uc2UYByAB",
-            "KAsyHC5wYWNrZXRzLkRlbnlSZXNwb25zZU1lc3NhZ2VIABIoCgZwbGF5ZXIY",
-            "CCABKAsyFi5wYWNrZXRzLlBsYXllck1lc3NhZ2VIABI7ChBwbGF5ZXJfZGly",
-            "ZWN0aW9uGAkgASgLMh8ucGFja2V0cy5QbGF5ZXJEaXJlY3Rpb25NZXNzYWdl",
-            "SAASJgoFc3BvcmUYCiABKAsyFS5wYWNrZXRzLlNwb3JlTWVzc2FnZUgAEjcK",
-            "DnNwb3JlX2NvbnN1bWVkGAsgASgLMh0ucGFja2V0cy5TcG9yZUNvbnN1bWVk",
-            "TWVzc2FnZUgAEjEKC3Nwb3JlX2JhdGNoGAwgASgLMhoucGFja2V0cy5TcG9y",
-            "ZUJhdGNoTWVzc2FnZUgAEjkKD3BsYXllcl9jb25zdW1lZBgNIAEoCzIeLnBh",
-            "Y2tldHMuUGxheWVyQ29uc3VtZWRNZXNzYWdlSAASRAoVaGlzY29yZV9ib2Fy",
-            "ZF9yZXF1ZXN0GA4gASgLMiMucGFja2V0cy5IaXNjb3JlQm9hcmRSZXF1ZXN0",
-            "TWVzc2FnZUgAEioKB2hpc2NvcmUYDyABKAsyFy5wYWNrZXRzLkhpc2NvcmVN",
-            "ZXNzYWdlSAASNQoNaGlzY29yZV9ib2FyZBgQIAEoCzIcLnBhY2tldHMuSGlz",
-            "Y29yZUJvYXJkTWVzc2FnZUgAEkwKGGZpbmlzaF9icm93c2luZ19oaXNjb3Jl",
-            "cxgRIAEoCzIoLnBhY2tldHMuRmluaXNoZWRCcm93c2luZ0hpc2NvcmVzTWVz",
-            "c2FnZUgAEjcKDnNlYXJjaF9oaXNjb3JlGBIgASgLMh0ucGFja2V0cy5TZWFy",
-            "Y2hIaXNjb3JlTWVzc2FnZUgAEjAKCmRpc2Nvbm5lY3QYEyABKAsyGi5wYWNr",
-            "ZXRzLkRpc2Nvbm5lY3RNZXNzYWdlSABCBQoDbXNnQh5aC3BrZy9wYWNrZXRz",
-            "qgIOQ2xpZW50LlBhY2tldHNiBnByb3RvMw=="));
+            "Y3Rpb24YBiABKAESDQoFc3BlZWQYByABKAESDQoFY29sb3IYCCABKA0SDAoE",
+            "dGVhbRgJIAEoDRISCgp2ZWxvY2l0eV94GAogASgBEhIKCnZlbG9jaXR5X3kY",
+            "CyABKAESFwoPc3Bhd25fcHJvdGVjdGVkGAwgASgIIisKFlBsYXllckRpcmVj",
+            "dGlvbk1lc3NhZ2USEQoJZGlyZWN0aW9uGAIgASgBIisKE1BsYXllclRhcmdl",
+            "dE1lc3NhZ2USCQoBeBgBIAEoARIJCgF5GAIgASgBIhQKElBsYXllclNwbGl0",
+            "TWVzc2FnZSIUChJQbGF5ZXJFamVjdE1lc3NhZ2UiYgoMU3BvcmVNZXNzYWdl",
+            "EgoKAmlkGAEgASgEEgkKAXgYAiABKAESCQoBeRgDIAEoARIOCgZyYWRpdXMY",
+            "BCABKAESIAoEa2luZBgFIAEoDjISLnBhY2tldHMuU3BvcmVLaW5kIigKFFNw",
+            "b3JlQ29uc3VtZWRNZXNzYWdlEhAKCHNwb3JlX2lkGAEgASgEIjoKEVNwb3Jl",
+            "QmF0Y2hNZXNzYWdlEiUKBnNwb3JlcxgBIAMoCzIVLnBhY2tldHMuU3BvcmVN",
+            "ZXNzYWdlIioKFVBsYXllckNvbnN1bWVkTWVzc2FnZRIRCglwbGF5ZXJfaWQY",
+            "ASABKAQiHAoaSGlzY29yZUJvYXJkUmVxdWVzdE1lc3NhZ2UiOwoOSGlzY29y",
+            "ZU1lc3NhZ2USDAoEcmFuaxgBIAEoBBIMCgRuYW1lGAIgASgJEg0KBXNjb3Jl",
+            "GAMgASgEIkAKE0hpc2NvcmVCb2FyZE1lc3NhZ2USKQoIaGlzY29yZXMYASAD",
+            "KAsyFy5wYWNrZXRzLkhpc2NvcmVNZXNzYWdlIiEKH0ZpbmlzaGVkQnJvd3Np",
+            "bmdIaXNjb3Jlc01lc3NhZ2UiJAoUU2VhcmNoSGlzY29yZU1lc3NhZ2USDAoE",
+            "bmFtZRgBIAEoCSIjChFEaXNjb25uZWN0TWVzc2FnZRIOCgZyZWFzb24YASAB",
+            "KAkilAEKEFZpZXdFbnRlck1lc3NhZ2USJQoGc3BvcmVzGAIgAygLMhUucGFj",
+            "a2V0cy5TcG9yZU1lc3NhZ2USJwoHaGF6YXJkcxgDIAMoCzIWLnBhY2tldHMu",
+            "SGF6YXJkTWVzc2FnZRIqCglwb3dlcl91cHMYBCADKAsyFy5wYWNrZXRzLlBv",
+            "d2VyVXBNZXNzYWdlSgQIARACIlUKEFZpZXdMZWF2ZU1lc3NhZ2USEQoJc3Bv",
+            "cmVfaWRzGAIgAygEEhIKCmhhemFyZF9pZHMYAyADKAQSFAoMcG93ZXJfdXBf",
+            "aWRzGAQgAygESgQIARACIkEKDUhhemFyZE1lc3NhZ2USCgoCaWQYASABKAQS",
+            "CQoBeBgCIAEoARIJCgF5GAMgASgBEg4KBnJhZGl1cxgEIAEoASI6ChJIYXph",
+            "cmRCdXJzdE1lc3NhZ2USEQoJaGF6YXJkX2lkGAEgASgEEhEKCXBsYXllcl9p",
+            "ZBgCIAEoBCJmCg5Qb3dlclVwTWVzc2FnZRIKCgJpZBgBIAEoBBIJCgF4GAIg",
+            "ASgBEgkKAXkYAyABKAESDgoGcmFkaXVzGAQgASgBEiIKBGtpbmQYBSABKA4y",
+            "FC5wYWNrZXRzLlBvd2VyVXBLaW5kInoKF1Bvd2VyVXBDb2xsZWN0ZWRNZXNz",
+            "YWdlEhMKC3Bvd2VyX3VwX2lkGAEgASgEEhEKCXBsYXllcl9pZBgCIAEoBBIi",
+            "CgRraW5kGAMgASgOMhQucGFja2V0cy5Qb3dlclVwS2luZBITCgtkdXJhdGlv",
+            "bl9tcxgEIAEoDSJOChVQb3dlclVwRXhwaXJlZE1lc3NhZ2USEQoJcGxheWVy",
+            "X2lkGAEgASgEEiIKBGtpbmQYAiABKA4yFC5wYWNrZXRzLlBvd2VyVXBLaW5k",
+            "IogDChJQbGF5ZXJEZWx0YU1lc3NhZ2USCgoCaWQYASABKAQSEQoEbmFtZRgC",
+            "IAEoCUgAiAEBEg4KAXgYAyABKAFIAYgBARIOCgF5GAQgASgBSAKIAQESEwoG",
+            "cmFkaXVzGAUgASgBSAOIAQESFgoJZGlyZWN0aW9uGAYgASgBSASIAQESEgoF",
+            "c3BlZWQYByABKAFIBYgBARISCgVjb2xvchgIIAEoDUgGiAEBEhEKBHRlYW0Y",
+            "CSABKA1IB4gBARIXCgp2ZWxvY2l0eV94GAogASgBSAiIAQESFwoKdmVsb2Np",
+            "dHlfeRgLIAEoAUgJiAEBEhwKD3NwYXduX3Byb3RlY3RlZBgMIAEoCEgKiAEB",
+            "QgcKBV9uYW1lQgQKAl94QgQKAl95QgkKB19yYWRpdXNCDAoKX2RpcmVjdGlv",
+            "bkIICgZfc3BlZWRCCAoGX2NvbG9yQgcKBV90ZWFtQg0KC192ZWxvY2l0eV94",
+            "Qg0KC192ZWxvY2l0eV95QhIKEF9zcGF3bl9wcm90ZWN0ZWQiUQoLQ2VsbE1l",
+            "c3NhZ2USCgoCaWQYASABKAQSEAoIb3duZXJfaWQYAiABKAQSCQoBeBgDIAEo",
+            "ARIJCgF5GAQgASgBEg4KBnJhZGl1cxgFIAEoASKkAQoPU25hcHNob3RNZXNz",
+            "YWdlEhAKCHNlcXVlbmNlGAEgASgEEhAKCGJhc2VsaW5lGAIgASgEEiwKB3Bs",
+            "YXllcnMYAyADKAsyGy5wYWNrZXRzLlBsYXllckRlbHRhTWVzc2FnZRIaChJy",
+            "ZW1vdmVkX3BsYXllcl9pZHMYBCADKAQSIwoFY2VsbHMYBSADKAsyFC5wYWNr",
+            "ZXRzLkNlbGxNZXNzYWdlIiYKElNuYXBzaG90QWNrTWVzc2FnZRIQCghzZXF1",
+            "ZW5jZRgBIAEoBCJKCgtSb29tTWVzc2FnZRIKCgJpZBgBIAEoBBIMCgRuYW1l",
+            "GAIgASgJEg8KB3BsYXllcnMYAyABKA0SEAoIY2FwYWNpdHkYBCABKA0iGAoW",
+            "Um9vbUxpc3RSZXF1ZXN0TWVzc2FnZSI2Cg9Sb29tTGlzdE1lc3NhZ2USIwoF",
+            "cm9vbXMYASADKAsyFC5wYWNrZXRzLlJvb21NZXNzYWdlIikKFkpvaW5Sb29t",
+            "UmVxdWVzdE1lc3NhZ2USDwoHcm9vbV9pZBgBIAEoBCI6ChhDcmVhdGVSb29t",
+            "UmVxdWVzdE1lc3NhZ2USDAoEbmFtZRgBIAEoCRIQCghjYXBhY2l0eRgCIAEo",
+            "DSI6ChJSb29tQ3JlYXRlZE1lc3NhZ2USDwoHcm9vbV9pZBgBIAEoBBITCgtp",
+            "bnZpdGVfY29kZRgCIAEoCSIzChxKb2luUm9vbUJ5Q29kZVJlcXVlc3RNZXNz",
+            "YWdlEhMKC2ludml0ZV9jb2RlGAEgASgJIi0KGEtpY2tQbGF5ZXJSZXF1ZXN0",
+            "TWVzc2FnZRIRCglwbGF5ZXJfaWQYASABKAQiKAoWTG9ja1Jvb21SZXF1ZXN0",
+            "TWVzc2FnZRIOCgZsb2NrZWQYASABKAgiGQoXQ2xvc2VSb29tUmVxdWVzdE1l",
+            "c3NhZ2UiKAoWUmVtb3ZlZEZyb21Sb29tTWVzc2FnZRIOCgZyZWFzb24YASAB",
+            "KAkiOQoPR2FtZU92ZXJNZXNzYWdlEhEKCXdpbm5lcl9pZBgBIAEoBBITCgt3",
+            "aW5uZXJfbmFtZRgCIAEoCSI4ChVUZWFtQXNzaWdubWVudE1lc3NhZ2USEQoJ",
+            "cGxheWVyX2lkGAEgASgEEgwKBHRlYW0YAiABKA0iXAoQVGVhbVNjb3JlTWVz",
+            "c2FnZRIMCgR0ZWFtGAEgASgNEgwKBG5hbWUYAiABKAkSDQoFY29sb3IYAyAB",
+            "KA0SDAoEbWFzcxgEIAEoBBIPCgdwbGF5ZXJzGAUgASgNIkEKFVRlYW1TY29y",
+            "ZWJvYXJkTWVzc2FnZRIoCgV0ZWFtcxgBIAMoCzIZLnBhY2tldHMuVGVhbVNj",
+            "b3JlTWVzc2FnZSItChVSb3VuZENvdW50ZG93bk1lc3NhZ2USFAoMc2Vjb25k",
+            "c19sZWZ0GAEgASgNIlEKElJvdW5kUmVzdWx0TWVzc2FnZRIRCglwbGF5ZXJf",
+            "aWQYASABKAQSDAoEbmFtZRgCIAEoCRIMCgRtYXNzGAMgASgEEgwKBHJhbmsY",
+            "BCABKA0iPwoPUm91bmRFbmRNZXNzYWdlEiwKB3Jlc3VsdHMYASADKAsyGy5w",
+            "YWNrZXRzLlJvdW5kUmVzdWx0TWVzc2FnZSJQChJXb3JsZEJvdW5kc01lc3Nh",
+            "Z2USDQoFbWluX3gYASABKAESDQoFbWluX3kYAiABKAESDQoFbWF4X3gYAyAB",
+            "KAESDQoFbWF4X3kYBCABKAEiSgoLWm9uZU1lc3NhZ2USCQoBeBgBIAEoARIJ",
+            "CgF5GAIgASgBEg4KBnJhZGl1cxgDIAEoARIVCg10YXJnZXRfcmFkaXVzGAQg",
+            "ASgBIk4KDkdhbWVNYXBNZXNzYWdlEgwKBG5hbWUYASABKAkSLgoJb2JzdGFj",
+            "bGVzGAIgAygLMhsucGFja2V0cy5Xb3JsZEJvdW5kc01lc3NhZ2UijAEKE0Rl",
+            "YXRoU3VtbWFyeU1lc3NhZ2USEQoJa2lsbGVyX2lkGAEgASgEEhMKC2tpbGxl",
+            "cl9uYW1lGAIgASgJEhUKDXRpbWVfYWxpdmVfbXMYAyABKAQSEQoJcGVha19t",
+            "YXNzGAQgASgBEg0KBWtpbGxzGAUgASgNEhQKDHNwb3Jlc19lYXRlbhgGIAEo",
+            "DSIXChVSZXNwYXduUmVxdWVzdE1lc3NhZ2UiLQoaU3BlY3RhdGVSb29tUmVx",
+            "dWVzdE1lc3NhZ2USDwoHcm9vbV9pZBgBIAEoBCIvChpGb2xsb3dQbGF5ZXJS",
+            "ZXF1ZXN0TWVzc2FnZRIRCglwbGF5ZXJfaWQYASABKAQiNQohQ3ljbGVTcGVj",
+            "dGF0ZVRhcmdldFJlcXVlc3RNZXNzYWdlEhAKCHByZXZpb3VzGAEgASgIIkgK",
+            "FVNwZWN0YXRlVGFyZ2V0TWVzc2FnZRIRCglwbGF5ZXJfaWQYASABKAQSDAoE",
+            "bmFtZRgCIAEoCRIOCgZsZWFkZXIYAyABKAgiiBcKBlBhY2tldBIRCglzZW5k",
+            "ZXJfaWQYASABKAQSJAoEY2hhdBgCIAEoCzIULnBhY2tldHMuQ2hhdE1lc3Nh",
+            "Z2VIABIgCgJpZBgDIAEoCzISLnBhY2tldHMuSWRNZXNzYWdlSAASNQoNbG9n",
+            "aW5fcmVxdWVzdBgEIAEoCzIcLnBhY2tldHMuTG9naW5SZXF1ZXN0TWVzc2Fn",
+            "ZUgAEjsKEHJlZ2lzdGVyX3JlcXVlc3QYBSABKAsyHy5wYWNrZXRzLlJlZ2lz",
+            "dGVyUmVxdWVzdE1lc3NhZ2VIABIxCgtva19yZXNwb25zZRgGIAEoCzIaLnBh",
+            "Y2tldHMuT2tSZXNwb25zZU1lc3NhZ2VIABI1Cg1kZW55X3Jlc3BvbnNlGAcg",
+            "ASgLMhwucGFja2V0cy5EZW55UmVzcG9uc2VNZXNzYWdlSAASKAoGcGxheWVy",
+            "GAggASgLMhYucGFja2V0cy5QbGF5ZXJNZXNzYWdlSAASOwoQcGxheWVyX2Rp",
+            "cmVjdGlvbhgJIAEoCzIfLnBhY2tldHMuUGxheWVyRGlyZWN0aW9uTWVzc2Fn",
+            "ZUgAEiYKBXNwb3JlGAogASgLMhUucGFja2V0cy5TcG9yZU1lc3NhZ2VIABI3",
+            "Cg5zcG9yZV9jb25zdW1lZBgLIAEoCzIdLnBhY2tldHMuU3BvcmVDb25zdW1l",
+            "ZE1lc3NhZ2VIABIxCgtzcG9yZV9iYXRjaBgMIAEoCzIaLnBhY2tldHMuU3Bv",
+            "cmVCYXRjaE1lc3NhZ2VIABI5Cg9wbGF5ZXJfY29uc3VtZWQYDSABKAsyHi5w",
+            "YWNrZXRzLlBsYXllckNvbnN1bWVkTWVzc2FnZUgAEkQKFWhpc2NvcmVfYm9h",
+            "cmRfcmVxdWVzdBgOIAEoCzIjLnBhY2tldHMuSGlzY29yZUJvYXJkUmVxdWVz",
+            "dE1lc3NhZ2VIABIqCgdoaXNjb3JlGA8gASgLMhcucGFja2V0cy5IaXNjb3Jl",
+            "TWVzc2FnZUgAEjUKDWhpc2NvcmVfYm9hcmQYECABKAsyHC5wYWNrZXRzLkhp",
+            "c2NvcmVCb2FyZE1lc3NhZ2VIABJMChhmaW5pc2hfYnJvd3NpbmdfaGlzY29y",
+            "ZXMYESABKAsyKC5wYWNrZXRzLkZpbmlzaGVkQnJvd3NpbmdIaXNjb3Jlc01l",
+            "c3NhZ2VIABI3Cg5zZWFyY2hfaGlzY29yZRgSIAEoCzIdLnBhY2tldHMuU2Vh",
+            "cmNoSGlzY29yZU1lc3NhZ2VIABIwCgpkaXNjb25uZWN0GBMgASgLMhoucGFj",
+            "a2V0cy5EaXNjb25uZWN0TWVzc2FnZUgAEi8KCnZpZXdfZW50ZXIYFCABKAsy",
+            "GS5wYWNrZXRzLlZpZXdFbnRlck1lc3NhZ2VIABIvCgp2aWV3X2xlYXZlGBUg",
+            "ASgLMhkucGFja2V0cy5WaWV3TGVhdmVNZXNzYWdlSAASLAoIc25hcHNob3QY",
+            "FiABKAsyGC5wYWNrZXRzLlNuYXBzaG90TWVzc2FnZUgAEjMKDHNuYXBzaG90",
+            "X2FjaxgXIAEoCzIbLnBhY2tldHMuU25hcHNob3RBY2tNZXNzYWdlSAASPAoR",
+            "cm9vbV9saXN0X3JlcXVlc3QYGCABKAsyHy5wYWNrZXRzLlJvb21MaXN0UmVx",
+            "dWVzdE1lc3NhZ2VIABItCglyb29tX2xpc3QYGSABKAsyGC5wYWNrZXRzLlJv",
+            "b21MaXN0TWVzc2FnZUgAEjwKEWpvaW5fcm9vbV9yZXF1ZXN0GBogASgLMh8u",
+            "cGFja2V0cy5Kb2luUm9vbVJlcXVlc3RNZXNzYWdlSAASQAoTY3JlYXRlX3Jv",
+            "b21fcmVxdWVzdBgbIAEoCzIhLnBhY2tldHMuQ3JlYXRlUm9vbVJlcXVlc3RN",
+            "ZXNzYWdlSAASMwoMcm9vbV9jcmVhdGVkGBwgASgLMhsucGFja2V0cy5Sb29t",
+            "Q3JlYXRlZE1lc3NhZ2VIABJKChlqb2luX3Jvb21fYnlfY29kZV9yZXF1ZXN0",
+            "GB0gASgLMiUucGFja2V0cy5Kb2luUm9vbUJ5Q29kZVJlcXVlc3RNZXNzYWdl",
+            "SAASQAoTa2lja19wbGF5ZXJfcmVxdWVzdBgeIAEoCzIhLnBhY2tldHMuS2lj",
+            "a1BsYXllclJlcXVlc3RNZXNzYWdlSAASPAoRbG9ja19yb29tX3JlcXVlc3QY",
+            "HyABKAsyHy5wYWNrZXRzLkxvY2tSb29tUmVxdWVzdE1lc3NhZ2VIABI+ChJj",
+            "bG9zZV9yb29tX3JlcXVlc3QYICABKAsyIC5wYWNrZXRzLkNsb3NlUm9vbVJl",
+            "cXVlc3RNZXNzYWdlSAASPAoRcmVtb3ZlZF9mcm9tX3Jvb20YISABKAsyHy5w",
+            "YWNrZXRzLlJlbW92ZWRGcm9tUm9vbU1lc3NhZ2VIABItCglnYW1lX292ZXIY",
+            "IiABKAsyGC5wYWNrZXRzLkdhbWVPdmVyTWVzc2FnZUgAEjkKD3RlYW1fYXNz",
+            "aWdubWVudBgjIAEoCzIeLnBhY2tldHMuVGVhbUFzc2lnbm1lbnRNZXNzYWdl",
+            "SAASOQoPdGVhbV9zY29yZWJvYXJkGCQgASgLMh4ucGFja2V0cy5UZWFtU2Nv",
+            "cmVib2FyZE1lc3NhZ2VIABI5Cg9yb3VuZF9jb3VudGRvd24YJSABKAsyHi5w",
+            "YWNrZXRzLlJvdW5kQ291bnRkb3duTWVzc2FnZUgAEi0KCXJvdW5kX2VuZBgm",
+            "IAEoCzIYLnBhY2tldHMuUm91bmRFbmRNZXNzYWdlSAASJAoEem9uZRgnIAEo",
+            "CzIULnBhY2tldHMuWm9uZU1lc3NhZ2VIABIzCgx3b3JsZF9ib3VuZHMYKCAB",
+            "KAsyGy5wYWNrZXRzLldvcmxkQm91bmRzTWVzc2FnZUgAEjMKDHBsYXllcl9z",
+            "cGxpdBgpIAEoCzIbLnBhY2tldHMuUGxheWVyU3BsaXRNZXNzYWdlSAASMwoM",
+            "cGxheWVyX2VqZWN0GCogASgLMhsucGFja2V0cy5QbGF5ZXJFamVjdE1lc3Nh",
+            "Z2VIABI1Cg1wbGF5ZXJfdGFyZ2V0GCsgASgLMhwucGFja2V0cy5QbGF5ZXJU",
+            "YXJnZXRNZXNzYWdlSAASMwoMaGF6YXJkX2J1cnN0GCwgASgLMhsucGFja2V0",
+            "cy5IYXphcmRCdXJzdE1lc3NhZ2VIABI+ChJwb3dlcl91cF9jb2xsZWN0ZWQY",
+            "LSABKAsyIC5wYWNrZXRzLlBvd2VyVXBDb2xsZWN0ZWRNZXNzYWdlSAASOgoQ",
+            "cG93ZXJfdXBfZXhwaXJlZBguIAEoCzIeLnBhY2tldHMuUG93ZXJVcEV4cGly",
+            "ZWRNZXNzYWdlSAASKwoIZ2FtZV9tYXAYLyABKAsyFy5wYWNrZXRzLkdhbWVN",
+            "YXBNZXNzYWdlSAASNQoNZGVhdGhfc3VtbWFyeRgwIAEoCzIcLnBhY2tldHMu",
+            "RGVhdGhTdW1tYXJ5TWVzc2FnZUgAEjkKD3Jlc3Bhd25fcmVxdWVzdBgxIAEo",
+            "CzIeLnBhY2tldHMuUmVzcGF3blJlcXVlc3RNZXNzYWdlSAASRAoVc3BlY3Rh",
+            "dGVfcm9vbV9yZXF1ZXN0GDIgASgLMiMucGFja2V0cy5TcGVjdGF0ZVJvb21S",
+            "ZXF1ZXN0TWVzc2FnZUgAEkQKFWZvbGxvd19wbGF5ZXJfcmVxdWVzdBgzIAEo",
+            "CzIjLnBhY2tldHMuRm9sbG93UGxheWVyUmVxdWVzdE1lc3NhZ2VIABJTCh1j",
+            "eWNsZV9zcGVjdGF0ZV90YXJnZXRfcmVxdWVzdBg0IAEoCzIqLnBhY2tldHMu",
+            "Q3ljbGVTcGVjdGF0ZVRhcmdldFJlcXVlc3RNZXNzYWdlSAASOQoPc3BlY3Rh",
+            "dGVfdGFyZ2V0GDUgASgLMh4ucGFja2V0cy5TcGVjdGF0ZVRhcmdldE1lc3Nh",
+            "Z2VIAEIFCgNtc2cqQQoJU3BvcmVLaW5kEhAKDFNQT1JFX0NPTU1PThAAEhAK",
+            "DFNQT1JFX0dPTERFThABEhAKDFNQT1JFX1BPSVNPThACKl4KC1Bvd2VyVXBL",
+            "aW5kEhEKDVBPV0VSX1VQX05PTkUQABISCg5QT1dFUl9VUF9TUEVFRBABEhMK",
+            "D1BPV0VSX1VQX1NISUVMRBACEhMKD1BPV0VSX1VQX01BR05FVBADQh5aC3Br",
+            "Zy9wYWNrZXRzqgIOQ2xpZW50LlBhY2tldHNiBnByb3RvMw=="));
       descriptor = pbr::FileDescriptor.FromGeneratedCode(descriptorData,
           new pbr::FileDescriptor[] { },
-          new pbr::GeneratedClrTypeInfo(null, null, new pbr::GeneratedClrTypeInfo[] {
+          new pbr::GeneratedClrTypeInfo(new[] {typeof(global::Client.Packets.SporeKind), typeof(global::Client.Packets.PowerUpKind), }, null, new pbr::GeneratedClrTypeInfo[] {
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.ChatMessage), global::Client.Packets.ChatMessage.Parser, new[]{ "Msg" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.IdMessage), global::Client.Packets.IdMessage.Parser, new[]{ "Id" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.LoginRequestMessage), global::Client.Packets.LoginRequestMessage.Parser, new[]{ "Username", "Password" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RegisterRequestMessage), global::Client.Packets.RegisterRequestMessage.Parser, new[]{ "Username", "Password", "Color" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.OkResponseMessage), global::Client.Packets.OkResponseMessage.Parser, null, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.DenyResponseMessage), global::Client.Packets.DenyResponseMessage.Parser, new[]{ "Msg" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerMessage), global::Client.Packets.PlayerMessage.Parser, new[]{ "Id", "Name", "X", "Y", "Radius", "Direction", "Speed", "Color" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerMessage), global::Client.Packets.PlayerMessage.Parser, new[]{ "Id", "Name", "X", "Y", "Radius", "Direction", "Speed", "Color", "Team", "VelocityX", "VelocityY", "SpawnProtected" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerDirectionMessage), global::Client.Packets.PlayerDirectionMessage.Parser, new[]{ "Direction" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SporeMessage), global::Client.Packets.SporeMessage.Parser, new[]{ "Id", "X", "Y", "Radius" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerTargetMessage), global::Client.Packets.PlayerTargetMessage.Parser, new[]{ "X", "Y" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerSplitMessage), global::Client.Packets.PlayerSplitMessage.Parser, null, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerEjectMessage), global::Client.Packets.PlayerEjectMessage.Parser, null, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SporeMessage), global::Client.Packets.SporeMessage.Parser, new[]{ "Id", "X", "Y", "Radius", "Kind" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SporeConsumedMessage), global::Client.Packets.SporeConsumedMessage.Parser, new[]{ "SporeId" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SporeBatchMessage), global::Client.Packets.SporeBatchMessage.Parser, new[]{ "Spores" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerConsumedMessage), global::Client.Packets.PlayerConsumedMessage.Parser, new[]{ "PlayerId" }, null, null, null, null),
@@ -89,12 +210,78 @@ namespace Client.Packets {
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.FinishedBrowsingHiscoresMessage), global::Client.Packets.FinishedBrowsingHiscoresMessage.Parser, null, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SearchHiscoreMessage), global::Client.Packets.SearchHiscoreMessage.Parser, new[]{ "Name" }, null, null, null, null),
             new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.DisconnectMessage), global::Client.Packets.DisconnectMessage.Parser, new[]{ "Reason" }, null, null, null, null),
-            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.Packet), global::Client.Packets.Packet.Parser, new[]{ "SenderId", "Chat", "Id", "LoginRequest", "RegisterRequest", "OkResponse", "DenyResponse", "Player", "PlayerDirection", "Spore", "SporeConsumed", "SporeBatch", "PlayerConsumed", "HiscoreBoardRequest", "Hiscore", "HiscoreBoard", "FinishBrowsingHiscores", "SearchHiscore", "Disconnect" }, new[]{ "Msg" }, null, null, null)
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.ViewEnterMessage), global::Client.Packets.ViewEnterMessage.Parser, new[]{ "Spores", "Hazards", "PowerUps" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.ViewLeaveMessage), global::Client.Packets.ViewLeaveMessage.Parser, new[]{ "SporeIds", "HazardIds", "PowerUpIds" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.HazardMessage), global::Client.Packets.HazardMessage.Parser, new[]{ "Id", "X", "Y", "Radius" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.HazardBurstMessage), global::Client.Packets.HazardBurstMessage.Parser, new[]{ "HazardId", "PlayerId" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PowerUpMessage), global::Client.Packets.PowerUpMessage.Parser, new[]{ "Id", "X", "Y", "Radius", "Kind" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PowerUpCollectedMessage), global::Client.Packets.PowerUpCollectedMessage.Parser, new[]{ "PowerUpId", "PlayerId", "Kind", "DurationMs" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PowerUpExpiredMessage), global::Client.Packets.PowerUpExpiredMessage.Parser, new[]{ "PlayerId", "Kind" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.PlayerDeltaMessage), global::Client.Packets.PlayerDeltaMessage.Parser, new[]{ "Id", "Name", "X", "Y", "Radius", "Direction", "Speed", "Color", "Team", "VelocityX", "VelocityY", "SpawnProtected" }, new[]{ "Name", "X", "Y", "Radius", "Direction", "Speed", "Color", "Team", "VelocityX", "VelocityY", "SpawnProtected" }, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.CellMessage), global::Client.Packets.CellMessage.Parser, new[]{ "Id", "OwnerId", "X", "Y", "Radius" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SnapshotMessage), global::Client.Packets.SnapshotMessage.Parser, new[]{ "Sequence", "Baseline", "Players", "RemovedPlayerIds", "Cells" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SnapshotAckMessage), global::Client.Packets.SnapshotAckMessage.Parser, new[]{ "Sequence" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RoomMessage), global::Client.Packets.RoomMessage.Parser, new[]{ "Id", "Name", "Players", "Capacity" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RoomListRequestMessage), global::Client.Packets.RoomListRequestMessage.Parser, null, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RoomListMessage), global::Client.Packets.RoomListMessage.Parser, new[]{ "Rooms" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.JoinRoomRequestMessage), global::Client.Packets.JoinRoomRequestMessage.Parser, new[]{ "RoomId" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.CreateRoomRequestMessage), global::Client.Packets.CreateRoomRequestMessage.Parser, new[]{ "Name", "Capacity" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RoomCreatedMessage), global::Client.Packets.RoomCreatedMessage.Parser, new[]{ "RoomId", "InviteCode" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.JoinRoomByCodeRequestMessage), global::Client.Packets.JoinRoomByCodeRequestMessage.Parser, new[]{ "InviteCode" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.KickPlayerRequestMessage), global::Client.Packets.KickPlayerRequestMessage.Parser, new[]{ "PlayerId" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.LockRoomRequestMessage), global::Client.Packets.LockRoomRequestMessage.Parser, new[]{ "Locked" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.CloseRoomRequestMessage), global::Client.Packets.CloseRoomRequestMessage.Parser, null, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RemovedFromRoomMessage), global::Client.Packets.RemovedFromRoomMessage.Parser, new[]{ "Reason" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.GameOverMessage), global::Client.Packets.GameOverMessage.Parser, new[]{ "WinnerId", "WinnerName" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.TeamAssignmentMessage), global::Client.Packets.TeamAssignmentMessage.Parser, new[]{ "PlayerId", "Team" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.TeamScoreMessage), global::Client.Packets.TeamScoreMessage.Parser, new[]{ "Team", "Name", "Color", "Mass", "Players" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.TeamScoreboardMessage), global::Client.Packets.TeamScoreboardMessage.Parser, new[]{ "Teams" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RoundCountdownMessage), global::Client.Packets.RoundCountdownMessage.Parser, new[]{ "SecondsLeft" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RoundResultMessage), global::Client.Packets.RoundResultMessage.Parser, new[]{ "PlayerId", "Name", "Mass", "Rank" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RoundEndMessage), global::Client.Packets.RoundEndMessage.Parser, new[]{ "Results" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.WorldBoundsMessage), global::Client.Packets.WorldBoundsMessage.Parser, new[]{ "MinX", "MinY", "MaxX", "MaxY" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.ZoneMessage), global::Client.Packets.ZoneMessage.Parser, new[]{ "X", "Y", "Radius", "TargetRadius" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.GameMapMessage), global::Client.Packets.GameMapMessage.Parser, new[]{ "Name", "Obstacles" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.DeathSummaryMessage), global::Client.Packets.DeathSummaryMessage.Parser, new[]{ "KillerId", "KillerName", "TimeAliveMs", "PeakMass", "Kills", "SporesEaten" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.RespawnRequestMessage), global::Client.Packets.RespawnRequestMessage.Parser, null, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SpectateRoomRequestMessage), global::Client.Packets.SpectateRoomRequestMessage.Parser, new[]{ "RoomId" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.FollowPlayerRequestMessage), global::Client.Packets.FollowPlayerRequestMessage.Parser, new[]{ "PlayerId" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.CycleSpectateTargetRequestMessage), global::Client.Packets.CycleSpectateTargetRequestMessage.Parser, new[]{ "Previous" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.SpectateTargetMessage), global::Client.Packets.SpectateTargetMessage.Parser, new[]{ "PlayerId", "Name", "Leader" }, null, null, null, null),
+            new pbr::GeneratedClrTypeInfo(typeof(global::Client.Packets.Packet), global::Client.Packets.Packet.Parser, new[]{ "SenderId", "Chat", "Id", "LoginRequest", "RegisterRequest", "OkResponse", "DenyResponse", "Player", "PlayerDirection", "Spore", "SporeConsumed", "SporeBatch", "PlayerConsumed", "HiscoreBoardRequest", "Hiscore", "HiscoreBoard", "FinishBrowsingHiscores", "SearchHiscore", "Disconnect", "ViewEnter", "ViewLeave", "Snapshot", "SnapshotAck", "RoomListRequest", "RoomList", "JoinRoomRequest", "CreateRoomRequest", "RoomCreated", "JoinRoomByCodeRequest", "KickPlayerRequest", "LockRoomRequest", "CloseRoomRequest", "RemovedFromRoom", "GameOver", "TeamAssignment", "TeamScoreboard", "RoundCountdown", "RoundEnd", "Zone", "WorldBounds", "PlayerSplit", "PlayerEject", "PlayerTarget", "HazardBurst", "PowerUpCollected", "PowerUpExpired", "GameMap", "DeathSummary", "RespawnRequest", "SpectateRoomRequest", "FollowPlayerRequest", "CycleSpectateTargetRequest", "SpectateTarget" }, new[]{ "Msg" }, null, null, null)
           }));
     }
     #endregion
 
   }
+  #region Enums
+  public enum SporeKind {
+    [pbr::OriginalName("SPORE_COMMON")] SporeCommon = 0,
+    /// <summary>
+    /// Worth several times its size
+    /// </summary>
+    [pbr::OriginalName("SPORE_GOLDEN")] SporeGolden = 1,
+    /// <summary>
+    /// Takes mass away instead of giving it
+    /// </summary>
+    [pbr::OriginalName("SPORE_POISON")] SporePoison = 2,
+  }
+
+  public enum PowerUpKind {
+    [pbr::OriginalName("POWER_UP_NONE")] PowerUpNone = 0,
+    [pbr::OriginalName("POWER_UP_SPEED")] PowerUpSpeed = 1,
+    /// <summary>
+    /// Can't be eaten while it lasts
+    /// </summary>
+    [pbr::OriginalName("POWER_UP_SHIELD")] PowerUpShield = 2,
+    /// <summary>
+    /// Pulls in nearby spores
+    /// </summary>
+    [pbr::OriginalName("POWER_UP_MAGNET")] PowerUpMagnet = 3,
+  }
+
+  #endregion
+
   #region Messages
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
   public sealed partial class ChatMessage : pb::IMessage<ChatMessage>
@@ -1401,6 +1588,10 @@ namespace Client.Packets {
       direction_ = other.direction_;
       speed_ = other.speed_;
       color_ = other.color_;
+      team_ = other.team_;
+      velocityX_ = other.velocityX_;
+      velocityY_ = other.velocityY_;
+      spawnProtected_ = other.spawnProtected_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
@@ -1506,6 +1697,60 @@ namespace Client.Packets {
       }
     }
 
+    /// <summary>Field number for the "team" field.</summary>
+    public const int TeamFieldNumber = 9;
+    private uint team_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Team {
+      get { return team_; }
+      set {
+        team_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "velocity_x" field.</summary>
+    public const int VelocityXFieldNumber = 10;
+    private double velocityX_;
+    /// <summary>
+    /// Where the player is actually heading, which lags behind direction while they turn
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double VelocityX {
+      get { return velocityX_; }
+      set {
+        velocityX_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "velocity_y" field.</summary>
+    public const int VelocityYFieldNumber = 11;
+    private double velocityY_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double VelocityY {
+      get { return velocityY_; }
+      set {
+        velocityY_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "spawn_protected" field.</summary>
+    public const int SpawnProtectedFieldNumber = 12;
+    private bool spawnProtected_;
+    /// <summary>
+    /// Just spawned, nobody can eat them yet
+    /// </summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool SpawnProtected {
+      get { return spawnProtected_; }
+      set {
+        spawnProtected_ = value;
+      }
+    }
+
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
@@ -1529,6 +1774,10 @@ namespace Client.Packets {
       if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Direction, other.Direction)) return false;
       if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Speed, other.Speed)) return false;
       if (Color != other.Color) return false;
+      if (Team != other.Team) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(VelocityX, other.VelocityX)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(VelocityY, other.VelocityY)) return false;
+      if (SpawnProtected != other.SpawnProtected) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -1544,6 +1793,10 @@ namespace Client.Packets {
       if (Direction != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Direction);
       if (Speed != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Speed);
       if (Color != 0) hash ^= Color.GetHashCode();
+      if (Team != 0) hash ^= Team.GetHashCode();
+      if (VelocityX != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(VelocityX);
+      if (VelocityY != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(VelocityY);
+      if (SpawnProtected != false) hash ^= SpawnProtected.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -1594,6 +1847,22 @@ namespace Client.Packets {
         output.WriteRawTag(64);
         output.WriteUInt32(Color);
       }
+      if (Team != 0) {
+        output.WriteRawTag(72);
+        output.WriteUInt32(Team);
+      }
+      if (VelocityX != 0D) {
+        output.WriteRawTag(81);
+        output.WriteDouble(VelocityX);
+      }
+      if (VelocityY != 0D) {
+        output.WriteRawTag(89);
+        output.WriteDouble(VelocityY);
+      }
+      if (SpawnProtected != false) {
+        output.WriteRawTag(96);
+        output.WriteBool(SpawnProtected);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -1636,6 +1905,22 @@ namespace Client.Packets {
         output.WriteRawTag(64);
         output.WriteUInt32(Color);
       }
+      if (Team != 0) {
+        output.WriteRawTag(72);
+        output.WriteUInt32(Team);
+      }
+      if (VelocityX != 0D) {
+        output.WriteRawTag(81);
+        output.WriteDouble(VelocityX);
+      }
+      if (VelocityY != 0D) {
+        output.WriteRawTag(89);
+        output.WriteDouble(VelocityY);
+      }
+      if (SpawnProtected != false) {
+        output.WriteRawTag(96);
+        output.WriteBool(SpawnProtected);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -1670,6 +1955,18 @@ namespace Client.Packets {
       if (Color != 0) {
         size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Color);
       }
+      if (Team != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Team);
+      }
+      if (VelocityX != 0D) {
+        size += 1 + 8;
+      }
+      if (VelocityY != 0D) {
+        size += 1 + 8;
+      }
+      if (SpawnProtected != false) {
+        size += 1 + 1;
+      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -1706,6 +2003,18 @@ namespace Client.Packets {
       if (other.Color != 0) {
         Color = other.Color;
       }
+      if (other.Team != 0) {
+        Team = other.Team;
+      }
+      if (other.VelocityX != 0D) {
+        VelocityX = other.VelocityX;
+      }
+      if (other.VelocityY != 0D) {
+        VelocityY = other.VelocityY;
+      }
+      if (other.SpawnProtected != false) {
+        SpawnProtected = other.SpawnProtected;
+      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -1757,6 +2066,22 @@ namespace Client.Packets {
             Color = input.ReadUInt32();
             break;
           }
+          case 72: {
+            Team = input.ReadUInt32();
+            break;
+          }
+          case 81: {
+            VelocityX = input.ReadDouble();
+            break;
+          }
+          case 89: {
+            VelocityY = input.ReadDouble();
+            break;
+          }
+          case 96: {
+            SpawnProtected = input.ReadBool();
+            break;
+          }
         }
       }
     #endif
@@ -1808,6 +2133,22 @@ namespace Client.Packets {
             Color = input.ReadUInt32();
             break;
           }
+          case 72: {
+            Team = input.ReadUInt32();
+            break;
+          }
+          case 81: {
+            VelocityX = input.ReadDouble();
+            break;
+          }
+          case 89: {
+            VelocityY = input.ReadDouble();
+            break;
+          }
+          case 96: {
+            SpawnProtected = input.ReadBool();
+            break;
+          }
         }
       }
     }
@@ -2013,17 +2354,20 @@ namespace Client.Packets {
 
   }
 
+  /// <summary>
+  /// Head for a point in the world, slowing down on the way in and stopping there. Sending a direction again cancels it.
+  /// </summary>
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class SporeMessage : pb::IMessage<SporeMessage>
+  public sealed partial class PlayerTargetMessage : pb::IMessage<PlayerTargetMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<SporeMessage> _parser = new pb::MessageParser<SporeMessage>(() => new SporeMessage());
+    private static readonly pb::MessageParser<PlayerTargetMessage> _parser = new pb::MessageParser<PlayerTargetMessage>(() => new PlayerTargetMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<SporeMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<PlayerTargetMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -2039,7 +2383,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeMessage() {
+    public PlayerTargetMessage() {
       OnConstruction();
     }
 
@@ -2047,34 +2391,20 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeMessage(SporeMessage other) : this() {
-      id_ = other.id_;
+    public PlayerTargetMessage(PlayerTargetMessage other) : this() {
       x_ = other.x_;
       y_ = other.y_;
-      radius_ = other.radius_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeMessage Clone() {
-      return new SporeMessage(this);
-    }
-
-    /// <summary>Field number for the "id" field.</summary>
-    public const int IdFieldNumber = 1;
-    private ulong id_;
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public ulong Id {
-      get { return id_; }
-      set {
-        id_ = value;
-      }
+    public PlayerTargetMessage Clone() {
+      return new PlayerTargetMessage(this);
     }
 
     /// <summary>Field number for the "x" field.</summary>
-    public const int XFieldNumber = 2;
+    public const int XFieldNumber = 1;
     private double x_;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -2086,7 +2416,7 @@ namespace Client.Packets {
     }
 
     /// <summary>Field number for the "y" field.</summary>
-    public const int YFieldNumber = 3;
+    public const int YFieldNumber = 2;
     private double y_;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -2097,37 +2427,23 @@ namespace Client.Packets {
       }
     }
 
-    /// <summary>Field number for the "radius" field.</summary>
-    public const int RadiusFieldNumber = 4;
-    private double radius_;
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public double Radius {
-      get { return radius_; }
-      set {
-        radius_ = value;
-      }
-    }
-
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as SporeMessage);
+      return Equals(other as PlayerTargetMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(SporeMessage other) {
+    public bool Equals(PlayerTargetMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (Id != other.Id) return false;
       if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(X, other.X)) return false;
       if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Y, other.Y)) return false;
-      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Radius, other.Radius)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -2135,10 +2451,8 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (Id != 0UL) hash ^= Id.GetHashCode();
       if (X != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(X);
       if (Y != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Y);
-      if (Radius != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Radius);
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -2157,22 +2471,14 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (Id != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(Id);
-      }
       if (X != 0D) {
-        output.WriteRawTag(17);
+        output.WriteRawTag(9);
         output.WriteDouble(X);
       }
       if (Y != 0D) {
-        output.WriteRawTag(25);
+        output.WriteRawTag(17);
         output.WriteDouble(Y);
       }
-      if (Radius != 0D) {
-        output.WriteRawTag(33);
-        output.WriteDouble(Radius);
-      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -2183,22 +2489,14 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (Id != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(Id);
-      }
       if (X != 0D) {
-        output.WriteRawTag(17);
+        output.WriteRawTag(9);
         output.WriteDouble(X);
       }
       if (Y != 0D) {
-        output.WriteRawTag(25);
+        output.WriteRawTag(17);
         output.WriteDouble(Y);
       }
-      if (Radius != 0D) {
-        output.WriteRawTag(33);
-        output.WriteDouble(Radius);
-      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -2209,18 +2507,12 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (Id != 0UL) {
-        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Id);
-      }
       if (X != 0D) {
         size += 1 + 8;
       }
       if (Y != 0D) {
         size += 1 + 8;
       }
-      if (Radius != 0D) {
-        size += 1 + 8;
-      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -2229,22 +2521,16 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(SporeMessage other) {
+    public void MergeFrom(PlayerTargetMessage other) {
       if (other == null) {
         return;
       }
-      if (other.Id != 0UL) {
-        Id = other.Id;
-      }
       if (other.X != 0D) {
         X = other.X;
       }
       if (other.Y != 0D) {
         Y = other.Y;
       }
-      if (other.Radius != 0D) {
-        Radius = other.Radius;
-      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -2264,22 +2550,14 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 8: {
-            Id = input.ReadUInt64();
-            break;
-          }
-          case 17: {
+          case 9: {
             X = input.ReadDouble();
             break;
           }
-          case 25: {
+          case 17: {
             Y = input.ReadDouble();
             break;
           }
-          case 33: {
-            Radius = input.ReadDouble();
-            break;
-          }
         }
       }
     #endif
@@ -2299,22 +2577,14 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 8: {
-            Id = input.ReadUInt64();
-            break;
-          }
-          case 17: {
+          case 9: {
             X = input.ReadDouble();
             break;
           }
-          case 25: {
+          case 17: {
             Y = input.ReadDouble();
             break;
           }
-          case 33: {
-            Radius = input.ReadDouble();
-            break;
-          }
         }
       }
     }
@@ -2323,16 +2593,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class SporeConsumedMessage : pb::IMessage<SporeConsumedMessage>
+  public sealed partial class PlayerSplitMessage : pb::IMessage<PlayerSplitMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<SporeConsumedMessage> _parser = new pb::MessageParser<SporeConsumedMessage>(() => new SporeConsumedMessage());
+    private static readonly pb::MessageParser<PlayerSplitMessage> _parser = new pb::MessageParser<PlayerSplitMessage>(() => new PlayerSplitMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<SporeConsumedMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<PlayerSplitMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -2348,7 +2618,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeConsumedMessage() {
+    public PlayerSplitMessage() {
       OnConstruction();
     }
 
@@ -2356,45 +2626,31 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeConsumedMessage(SporeConsumedMessage other) : this() {
-      sporeId_ = other.sporeId_;
+    public PlayerSplitMessage(PlayerSplitMessage other) : this() {
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeConsumedMessage Clone() {
-      return new SporeConsumedMessage(this);
-    }
-
-    /// <summary>Field number for the "spore_id" field.</summary>
-    public const int SporeIdFieldNumber = 1;
-    private ulong sporeId_;
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public ulong SporeId {
-      get { return sporeId_; }
-      set {
-        sporeId_ = value;
-      }
+    public PlayerSplitMessage Clone() {
+      return new PlayerSplitMessage(this);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as SporeConsumedMessage);
+      return Equals(other as PlayerSplitMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(SporeConsumedMessage other) {
+    public bool Equals(PlayerSplitMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (SporeId != other.SporeId) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -2402,7 +2658,6 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (SporeId != 0UL) hash ^= SporeId.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -2421,10 +2676,6 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (SporeId != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(SporeId);
-      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -2435,10 +2686,6 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (SporeId != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(SporeId);
-      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -2449,9 +2696,6 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (SporeId != 0UL) {
-        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(SporeId);
-      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -2460,13 +2704,10 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(SporeConsumedMessage other) {
+    public void MergeFrom(PlayerSplitMessage other) {
       if (other == null) {
         return;
       }
-      if (other.SporeId != 0UL) {
-        SporeId = other.SporeId;
-      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -2486,10 +2727,6 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 8: {
-            SporeId = input.ReadUInt64();
-            break;
-          }
         }
       }
     #endif
@@ -2509,10 +2746,6 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 8: {
-            SporeId = input.ReadUInt64();
-            break;
-          }
         }
       }
     }
@@ -2521,16 +2754,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class SporeBatchMessage : pb::IMessage<SporeBatchMessage>
+  public sealed partial class PlayerEjectMessage : pb::IMessage<PlayerEjectMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<SporeBatchMessage> _parser = new pb::MessageParser<SporeBatchMessage>(() => new SporeBatchMessage());
+    private static readonly pb::MessageParser<PlayerEjectMessage> _parser = new pb::MessageParser<PlayerEjectMessage>(() => new PlayerEjectMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<SporeBatchMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<PlayerEjectMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -2546,7 +2779,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeBatchMessage() {
+    public PlayerEjectMessage() {
       OnConstruction();
     }
 
@@ -2554,44 +2787,31 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeBatchMessage(SporeBatchMessage other) : this() {
-      spores_ = other.spores_.Clone();
+    public PlayerEjectMessage(PlayerEjectMessage other) : this() {
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SporeBatchMessage Clone() {
-      return new SporeBatchMessage(this);
-    }
-
-    /// <summary>Field number for the "spores" field.</summary>
-    public const int SporesFieldNumber = 1;
-    private static readonly pb::FieldCodec<global::Client.Packets.SporeMessage> _repeated_spores_codec
-        = pb::FieldCodec.ForMessage(10, global::Client.Packets.SporeMessage.Parser);
-    private readonly pbc::RepeatedField<global::Client.Packets.SporeMessage> spores_ = new pbc::RepeatedField<global::Client.Packets.SporeMessage>();
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public pbc::RepeatedField<global::Client.Packets.SporeMessage> Spores {
-      get { return spores_; }
+    public PlayerEjectMessage Clone() {
+      return new PlayerEjectMessage(this);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as SporeBatchMessage);
+      return Equals(other as PlayerEjectMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(SporeBatchMessage other) {
+    public bool Equals(PlayerEjectMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if(!spores_.Equals(other.spores_)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -2599,7 +2819,6 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      hash ^= spores_.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -2618,7 +2837,6 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      spores_.WriteTo(output, _repeated_spores_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -2629,7 +2847,6 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      spores_.WriteTo(ref output, _repeated_spores_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -2640,7 +2857,6 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      size += spores_.CalculateSize(_repeated_spores_codec);
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -2649,11 +2865,10 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(SporeBatchMessage other) {
+    public void MergeFrom(PlayerEjectMessage other) {
       if (other == null) {
         return;
       }
-      spores_.Add(other.spores_);
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -2673,10 +2888,6 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 10: {
-            spores_.AddEntriesFrom(input, _repeated_spores_codec);
-            break;
-          }
         }
       }
     #endif
@@ -2696,10 +2907,6 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 10: {
-            spores_.AddEntriesFrom(ref input, _repeated_spores_codec);
-            break;
-          }
         }
       }
     }
@@ -2708,16 +2915,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class PlayerConsumedMessage : pb::IMessage<PlayerConsumedMessage>
+  public sealed partial class SporeMessage : pb::IMessage<SporeMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<PlayerConsumedMessage> _parser = new pb::MessageParser<PlayerConsumedMessage>(() => new PlayerConsumedMessage());
+    private static readonly pb::MessageParser<SporeMessage> _parser = new pb::MessageParser<SporeMessage>(() => new SporeMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<PlayerConsumedMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<SporeMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -2733,7 +2940,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public PlayerConsumedMessage() {
+    public SporeMessage() {
       OnConstruction();
     }
 
@@ -2741,45 +2948,101 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public PlayerConsumedMessage(PlayerConsumedMessage other) : this() {
-      playerId_ = other.playerId_;
-      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    public SporeMessage(SporeMessage other) : this() {
+      id_ = other.id_;
+      x_ = other.x_;
+      y_ = other.y_;
+      radius_ = other.radius_;
+      kind_ = other.kind_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public PlayerConsumedMessage Clone() {
-      return new PlayerConsumedMessage(this);
+    public SporeMessage Clone() {
+      return new SporeMessage(this);
     }
 
-    /// <summary>Field number for the "player_id" field.</summary>
-    public const int PlayerIdFieldNumber = 1;
-    private ulong playerId_;
+    /// <summary>Field number for the "id" field.</summary>
+    public const int IdFieldNumber = 1;
+    private ulong id_;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public ulong PlayerId {
-      get { return playerId_; }
+    public ulong Id {
+      get { return id_; }
       set {
-        playerId_ = value;
+        id_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "x" field.</summary>
+    public const int XFieldNumber = 2;
+    private double x_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double X {
+      get { return x_; }
+      set {
+        x_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "y" field.</summary>
+    public const int YFieldNumber = 3;
+    private double y_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Y {
+      get { return y_; }
+      set {
+        y_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "radius" field.</summary>
+    public const int RadiusFieldNumber = 4;
+    private double radius_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Radius {
+      get { return radius_; }
+      set {
+        radius_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "kind" field.</summary>
+    public const int KindFieldNumber = 5;
+    private global::Client.Packets.SporeKind kind_ = global::Client.Packets.SporeKind.SporeCommon;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SporeKind Kind {
+      get { return kind_; }
+      set {
+        kind_ = value;
       }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as PlayerConsumedMessage);
+      return Equals(other as SporeMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(PlayerConsumedMessage other) {
+    public bool Equals(SporeMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (PlayerId != other.PlayerId) return false;
+      if (Id != other.Id) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(X, other.X)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Y, other.Y)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Radius, other.Radius)) return false;
+      if (Kind != other.Kind) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -2787,7 +3050,11 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (Id != 0UL) hash ^= Id.GetHashCode();
+      if (X != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(X);
+      if (Y != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Y);
+      if (Radius != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Radius);
+      if (Kind != global::Client.Packets.SporeKind.SporeCommon) hash ^= Kind.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -2806,9 +3073,25 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (PlayerId != 0UL) {
+      if (Id != 0UL) {
         output.WriteRawTag(8);
-        output.WriteUInt64(PlayerId);
+        output.WriteUInt64(Id);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Radius);
+      }
+      if (Kind != global::Client.Packets.SporeKind.SporeCommon) {
+        output.WriteRawTag(40);
+        output.WriteEnum((int) Kind);
       }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
@@ -2820,9 +3103,25 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (PlayerId != 0UL) {
+      if (Id != 0UL) {
         output.WriteRawTag(8);
-        output.WriteUInt64(PlayerId);
+        output.WriteUInt64(Id);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Radius);
+      }
+      if (Kind != global::Client.Packets.SporeKind.SporeCommon) {
+        output.WriteRawTag(40);
+        output.WriteEnum((int) Kind);
       }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
@@ -2834,8 +3133,20 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (PlayerId != 0UL) {
-        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      if (Id != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Id);
+      }
+      if (X != 0D) {
+        size += 1 + 8;
+      }
+      if (Y != 0D) {
+        size += 1 + 8;
+      }
+      if (Radius != 0D) {
+        size += 1 + 8;
+      }
+      if (Kind != global::Client.Packets.SporeKind.SporeCommon) {
+        size += 1 + pb::CodedOutputStream.ComputeEnumSize((int) Kind);
       }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
@@ -2845,12 +3156,24 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(PlayerConsumedMessage other) {
+    public void MergeFrom(SporeMessage other) {
       if (other == null) {
         return;
       }
-      if (other.PlayerId != 0UL) {
-        PlayerId = other.PlayerId;
+      if (other.Id != 0UL) {
+        Id = other.Id;
+      }
+      if (other.X != 0D) {
+        X = other.X;
+      }
+      if (other.Y != 0D) {
+        Y = other.Y;
+      }
+      if (other.Radius != 0D) {
+        Radius = other.Radius;
+      }
+      if (other.Kind != global::Client.Packets.SporeKind.SporeCommon) {
+        Kind = other.Kind;
       }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
@@ -2872,7 +3195,23 @@ namespace Client.Packets {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
           case 8: {
-            PlayerId = input.ReadUInt64();
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 17: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 40: {
+            Kind = (global::Client.Packets.SporeKind) input.ReadEnum();
             break;
           }
         }
@@ -2895,7 +3234,23 @@ namespace Client.Packets {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
           case 8: {
-            PlayerId = input.ReadUInt64();
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 17: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 40: {
+            Kind = (global::Client.Packets.SporeKind) input.ReadEnum();
             break;
           }
         }
@@ -2906,16 +3261,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class HiscoreBoardRequestMessage : pb::IMessage<HiscoreBoardRequestMessage>
+  public sealed partial class SporeConsumedMessage : pb::IMessage<SporeConsumedMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<HiscoreBoardRequestMessage> _parser = new pb::MessageParser<HiscoreBoardRequestMessage>(() => new HiscoreBoardRequestMessage());
+    private static readonly pb::MessageParser<SporeConsumedMessage> _parser = new pb::MessageParser<SporeConsumedMessage>(() => new SporeConsumedMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<HiscoreBoardRequestMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<SporeConsumedMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -2931,7 +3286,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreBoardRequestMessage() {
+    public SporeConsumedMessage() {
       OnConstruction();
     }
 
@@ -2939,31 +3294,45 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreBoardRequestMessage(HiscoreBoardRequestMessage other) : this() {
+    public SporeConsumedMessage(SporeConsumedMessage other) : this() {
+      sporeId_ = other.sporeId_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreBoardRequestMessage Clone() {
-      return new HiscoreBoardRequestMessage(this);
+    public SporeConsumedMessage Clone() {
+      return new SporeConsumedMessage(this);
+    }
+
+    /// <summary>Field number for the "spore_id" field.</summary>
+    public const int SporeIdFieldNumber = 1;
+    private ulong sporeId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong SporeId {
+      get { return sporeId_; }
+      set {
+        sporeId_ = value;
+      }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as HiscoreBoardRequestMessage);
+      return Equals(other as SporeConsumedMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(HiscoreBoardRequestMessage other) {
+    public bool Equals(SporeConsumedMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
+      if (SporeId != other.SporeId) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -2971,6 +3340,7 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
+      if (SporeId != 0UL) hash ^= SporeId.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -2989,6 +3359,10 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
+      if (SporeId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(SporeId);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -2999,6 +3373,10 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (SporeId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(SporeId);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -3009,6 +3387,9 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
+      if (SporeId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(SporeId);
+      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -3017,10 +3398,13 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(HiscoreBoardRequestMessage other) {
+    public void MergeFrom(SporeConsumedMessage other) {
       if (other == null) {
         return;
       }
+      if (other.SporeId != 0UL) {
+        SporeId = other.SporeId;
+      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -3040,6 +3424,10 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
+          case 8: {
+            SporeId = input.ReadUInt64();
+            break;
+          }
         }
       }
     #endif
@@ -3059,6 +3447,10 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
+          case 8: {
+            SporeId = input.ReadUInt64();
+            break;
+          }
         }
       }
     }
@@ -3067,16 +3459,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class HiscoreMessage : pb::IMessage<HiscoreMessage>
+  public sealed partial class SporeBatchMessage : pb::IMessage<SporeBatchMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<HiscoreMessage> _parser = new pb::MessageParser<HiscoreMessage>(() => new HiscoreMessage());
+    private static readonly pb::MessageParser<SporeBatchMessage> _parser = new pb::MessageParser<SporeBatchMessage>(() => new SporeBatchMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<HiscoreMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<SporeBatchMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -3092,7 +3484,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreMessage() {
+    public SporeBatchMessage() {
       OnConstruction();
     }
 
@@ -3100,73 +3492,44 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreMessage(HiscoreMessage other) : this() {
-      rank_ = other.rank_;
-      name_ = other.name_;
-      score_ = other.score_;
+    public SporeBatchMessage(SporeBatchMessage other) : this() {
+      spores_ = other.spores_.Clone();
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreMessage Clone() {
-      return new HiscoreMessage(this);
+    public SporeBatchMessage Clone() {
+      return new SporeBatchMessage(this);
     }
 
-    /// <summary>Field number for the "rank" field.</summary>
-    public const int RankFieldNumber = 1;
-    private ulong rank_;
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public ulong Rank {
-      get { return rank_; }
-      set {
-        rank_ = value;
-      }
-    }
-
-    /// <summary>Field number for the "name" field.</summary>
-    public const int NameFieldNumber = 2;
-    private string name_ = "";
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public string Name {
-      get { return name_; }
-      set {
-        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
-      }
-    }
-
-    /// <summary>Field number for the "score" field.</summary>
-    public const int ScoreFieldNumber = 3;
-    private ulong score_;
+    /// <summary>Field number for the "spores" field.</summary>
+    public const int SporesFieldNumber = 1;
+    private static readonly pb::FieldCodec<global::Client.Packets.SporeMessage> _repeated_spores_codec
+        = pb::FieldCodec.ForMessage(10, global::Client.Packets.SporeMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.SporeMessage> spores_ = new pbc::RepeatedField<global::Client.Packets.SporeMessage>();
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public ulong Score {
-      get { return score_; }
-      set {
-        score_ = value;
-      }
+    public pbc::RepeatedField<global::Client.Packets.SporeMessage> Spores {
+      get { return spores_; }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as HiscoreMessage);
+      return Equals(other as SporeBatchMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(HiscoreMessage other) {
+    public bool Equals(SporeBatchMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (Rank != other.Rank) return false;
-      if (Name != other.Name) return false;
-      if (Score != other.Score) return false;
+      if(!spores_.Equals(other.spores_)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -3174,9 +3537,7 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (Rank != 0UL) hash ^= Rank.GetHashCode();
-      if (Name.Length != 0) hash ^= Name.GetHashCode();
-      if (Score != 0UL) hash ^= Score.GetHashCode();
+      hash ^= spores_.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -3195,18 +3556,7 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (Rank != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(Rank);
-      }
-      if (Name.Length != 0) {
-        output.WriteRawTag(18);
-        output.WriteString(Name);
-      }
-      if (Score != 0UL) {
-        output.WriteRawTag(24);
-        output.WriteUInt64(Score);
-      }
+      spores_.WriteTo(output, _repeated_spores_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -3217,18 +3567,7 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (Rank != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(Rank);
-      }
-      if (Name.Length != 0) {
-        output.WriteRawTag(18);
-        output.WriteString(Name);
-      }
-      if (Score != 0UL) {
-        output.WriteRawTag(24);
-        output.WriteUInt64(Score);
-      }
+      spores_.WriteTo(ref output, _repeated_spores_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -3239,15 +3578,7 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (Rank != 0UL) {
-        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Rank);
-      }
-      if (Name.Length != 0) {
-        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
-      }
-      if (Score != 0UL) {
-        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Score);
-      }
+      size += spores_.CalculateSize(_repeated_spores_codec);
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -3256,19 +3587,11 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(HiscoreMessage other) {
+    public void MergeFrom(SporeBatchMessage other) {
       if (other == null) {
         return;
       }
-      if (other.Rank != 0UL) {
-        Rank = other.Rank;
-      }
-      if (other.Name.Length != 0) {
-        Name = other.Name;
-      }
-      if (other.Score != 0UL) {
-        Score = other.Score;
-      }
+      spores_.Add(other.spores_);
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -3288,16 +3611,8 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 8: {
-            Rank = input.ReadUInt64();
-            break;
-          }
-          case 18: {
-            Name = input.ReadString();
-            break;
-          }
-          case 24: {
-            Score = input.ReadUInt64();
+          case 10: {
+            spores_.AddEntriesFrom(input, _repeated_spores_codec);
             break;
           }
         }
@@ -3319,16 +3634,8 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 8: {
-            Rank = input.ReadUInt64();
-            break;
-          }
-          case 18: {
-            Name = input.ReadString();
-            break;
-          }
-          case 24: {
-            Score = input.ReadUInt64();
+          case 10: {
+            spores_.AddEntriesFrom(ref input, _repeated_spores_codec);
             break;
           }
         }
@@ -3339,16 +3646,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class HiscoreBoardMessage : pb::IMessage<HiscoreBoardMessage>
+  public sealed partial class PlayerConsumedMessage : pb::IMessage<PlayerConsumedMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<HiscoreBoardMessage> _parser = new pb::MessageParser<HiscoreBoardMessage>(() => new HiscoreBoardMessage());
+    private static readonly pb::MessageParser<PlayerConsumedMessage> _parser = new pb::MessageParser<PlayerConsumedMessage>(() => new PlayerConsumedMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<HiscoreBoardMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<PlayerConsumedMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -3364,7 +3671,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreBoardMessage() {
+    public PlayerConsumedMessage() {
       OnConstruction();
     }
 
@@ -3372,44 +3679,45 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreBoardMessage(HiscoreBoardMessage other) : this() {
-      hiscores_ = other.hiscores_.Clone();
+    public PlayerConsumedMessage(PlayerConsumedMessage other) : this() {
+      playerId_ = other.playerId_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public HiscoreBoardMessage Clone() {
-      return new HiscoreBoardMessage(this);
+    public PlayerConsumedMessage Clone() {
+      return new PlayerConsumedMessage(this);
     }
 
-    /// <summary>Field number for the "hiscores" field.</summary>
-    public const int HiscoresFieldNumber = 1;
-    private static readonly pb::FieldCodec<global::Client.Packets.HiscoreMessage> _repeated_hiscores_codec
-        = pb::FieldCodec.ForMessage(10, global::Client.Packets.HiscoreMessage.Parser);
-    private readonly pbc::RepeatedField<global::Client.Packets.HiscoreMessage> hiscores_ = new pbc::RepeatedField<global::Client.Packets.HiscoreMessage>();
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private ulong playerId_;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public pbc::RepeatedField<global::Client.Packets.HiscoreMessage> Hiscores {
-      get { return hiscores_; }
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as HiscoreBoardMessage);
+      return Equals(other as PlayerConsumedMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(HiscoreBoardMessage other) {
+    public bool Equals(PlayerConsumedMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if(!hiscores_.Equals(other.hiscores_)) return false;
+      if (PlayerId != other.PlayerId) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -3417,7 +3725,7 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      hash ^= hiscores_.GetHashCode();
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -3436,7 +3744,10 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      hiscores_.WriteTo(output, _repeated_hiscores_codec);
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -3447,7 +3758,10 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      hiscores_.WriteTo(ref output, _repeated_hiscores_codec);
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -3458,7 +3772,9 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      size += hiscores_.CalculateSize(_repeated_hiscores_codec);
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -3467,11 +3783,13 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(HiscoreBoardMessage other) {
+    public void MergeFrom(PlayerConsumedMessage other) {
       if (other == null) {
         return;
       }
-      hiscores_.Add(other.hiscores_);
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -3491,8 +3809,8 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 10: {
-            hiscores_.AddEntriesFrom(input, _repeated_hiscores_codec);
+          case 8: {
+            PlayerId = input.ReadUInt64();
             break;
           }
         }
@@ -3514,8 +3832,8 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 10: {
-            hiscores_.AddEntriesFrom(ref input, _repeated_hiscores_codec);
+          case 8: {
+            PlayerId = input.ReadUInt64();
             break;
           }
         }
@@ -3526,16 +3844,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class FinishedBrowsingHiscoresMessage : pb::IMessage<FinishedBrowsingHiscoresMessage>
+  public sealed partial class HiscoreBoardRequestMessage : pb::IMessage<HiscoreBoardRequestMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<FinishedBrowsingHiscoresMessage> _parser = new pb::MessageParser<FinishedBrowsingHiscoresMessage>(() => new FinishedBrowsingHiscoresMessage());
+    private static readonly pb::MessageParser<HiscoreBoardRequestMessage> _parser = new pb::MessageParser<HiscoreBoardRequestMessage>(() => new HiscoreBoardRequestMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<FinishedBrowsingHiscoresMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<HiscoreBoardRequestMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -3551,7 +3869,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public FinishedBrowsingHiscoresMessage() {
+    public HiscoreBoardRequestMessage() {
       OnConstruction();
     }
 
@@ -3559,25 +3877,25 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public FinishedBrowsingHiscoresMessage(FinishedBrowsingHiscoresMessage other) : this() {
+    public HiscoreBoardRequestMessage(HiscoreBoardRequestMessage other) : this() {
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public FinishedBrowsingHiscoresMessage Clone() {
-      return new FinishedBrowsingHiscoresMessage(this);
+    public HiscoreBoardRequestMessage Clone() {
+      return new HiscoreBoardRequestMessage(this);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as FinishedBrowsingHiscoresMessage);
+      return Equals(other as HiscoreBoardRequestMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(FinishedBrowsingHiscoresMessage other) {
+    public bool Equals(HiscoreBoardRequestMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
@@ -3637,7 +3955,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(FinishedBrowsingHiscoresMessage other) {
+    public void MergeFrom(HiscoreBoardRequestMessage other) {
       if (other == null) {
         return;
       }
@@ -3687,16 +4005,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class SearchHiscoreMessage : pb::IMessage<SearchHiscoreMessage>
+  public sealed partial class HiscoreMessage : pb::IMessage<HiscoreMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<SearchHiscoreMessage> _parser = new pb::MessageParser<SearchHiscoreMessage>(() => new SearchHiscoreMessage());
+    private static readonly pb::MessageParser<HiscoreMessage> _parser = new pb::MessageParser<HiscoreMessage>(() => new HiscoreMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<SearchHiscoreMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<HiscoreMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -3712,7 +4030,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SearchHiscoreMessage() {
+    public HiscoreMessage() {
       OnConstruction();
     }
 
@@ -3720,19 +4038,33 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SearchHiscoreMessage(SearchHiscoreMessage other) : this() {
+    public HiscoreMessage(HiscoreMessage other) : this() {
+      rank_ = other.rank_;
       name_ = other.name_;
+      score_ = other.score_;
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public SearchHiscoreMessage Clone() {
-      return new SearchHiscoreMessage(this);
+    public HiscoreMessage Clone() {
+      return new HiscoreMessage(this);
+    }
+
+    /// <summary>Field number for the "rank" field.</summary>
+    public const int RankFieldNumber = 1;
+    private ulong rank_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Rank {
+      get { return rank_; }
+      set {
+        rank_ = value;
+      }
     }
 
     /// <summary>Field number for the "name" field.</summary>
-    public const int NameFieldNumber = 1;
+    public const int NameFieldNumber = 2;
     private string name_ = "";
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -3743,22 +4075,36 @@ namespace Client.Packets {
       }
     }
 
+    /// <summary>Field number for the "score" field.</summary>
+    public const int ScoreFieldNumber = 3;
+    private ulong score_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Score {
+      get { return score_; }
+      set {
+        score_ = value;
+      }
+    }
+
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as SearchHiscoreMessage);
+      return Equals(other as HiscoreMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(SearchHiscoreMessage other) {
+    public bool Equals(HiscoreMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
+      if (Rank != other.Rank) return false;
       if (Name != other.Name) return false;
+      if (Score != other.Score) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -3766,7 +4112,9 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
+      if (Rank != 0UL) hash ^= Rank.GetHashCode();
       if (Name.Length != 0) hash ^= Name.GetHashCode();
+      if (Score != 0UL) hash ^= Score.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -3785,9 +4133,17 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (Name.Length != 0) {
-        output.WriteRawTag(10);
-        output.WriteString(Name);
+      if (Rank != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Rank);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Score != 0UL) {
+        output.WriteRawTag(24);
+        output.WriteUInt64(Score);
       }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
@@ -3799,10 +4155,18 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Rank != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Rank);
+      }
       if (Name.Length != 0) {
-        output.WriteRawTag(10);
+        output.WriteRawTag(18);
         output.WriteString(Name);
       }
+      if (Score != 0UL) {
+        output.WriteRawTag(24);
+        output.WriteUInt64(Score);
+      }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -3813,9 +4177,15 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
+      if (Rank != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Rank);
+      }
       if (Name.Length != 0) {
         size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
       }
+      if (Score != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Score);
+      }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -3824,13 +4194,19 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(SearchHiscoreMessage other) {
+    public void MergeFrom(HiscoreMessage other) {
       if (other == null) {
         return;
       }
+      if (other.Rank != 0UL) {
+        Rank = other.Rank;
+      }
       if (other.Name.Length != 0) {
         Name = other.Name;
       }
+      if (other.Score != 0UL) {
+        Score = other.Score;
+      }
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -3850,10 +4226,18 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
-          case 10: {
+          case 8: {
+            Rank = input.ReadUInt64();
+            break;
+          }
+          case 18: {
             Name = input.ReadString();
             break;
           }
+          case 24: {
+            Score = input.ReadUInt64();
+            break;
+          }
         }
       }
     #endif
@@ -3873,10 +4257,18 @@ namespace Client.Packets {
           default:
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
-          case 10: {
+          case 8: {
+            Rank = input.ReadUInt64();
+            break;
+          }
+          case 18: {
             Name = input.ReadString();
             break;
           }
+          case 24: {
+            Score = input.ReadUInt64();
+            break;
+          }
         }
       }
     }
@@ -3885,16 +4277,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class DisconnectMessage : pb::IMessage<DisconnectMessage>
+  public sealed partial class HiscoreBoardMessage : pb::IMessage<HiscoreBoardMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<DisconnectMessage> _parser = new pb::MessageParser<DisconnectMessage>(() => new DisconnectMessage());
+    private static readonly pb::MessageParser<HiscoreBoardMessage> _parser = new pb::MessageParser<HiscoreBoardMessage>(() => new HiscoreBoardMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<DisconnectMessage> Parser { get { return _parser; } }
+    public static pb::MessageParser<HiscoreBoardMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -3910,7 +4302,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public DisconnectMessage() {
+    public HiscoreBoardMessage() {
       OnConstruction();
     }
 
@@ -3918,45 +4310,44 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public DisconnectMessage(DisconnectMessage other) : this() {
-      reason_ = other.reason_;
+    public HiscoreBoardMessage(HiscoreBoardMessage other) : this() {
+      hiscores_ = other.hiscores_.Clone();
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public DisconnectMessage Clone() {
-      return new DisconnectMessage(this);
+    public HiscoreBoardMessage Clone() {
+      return new HiscoreBoardMessage(this);
     }
 
-    /// <summary>Field number for the "reason" field.</summary>
-    public const int ReasonFieldNumber = 1;
-    private string reason_ = "";
+    /// <summary>Field number for the "hiscores" field.</summary>
+    public const int HiscoresFieldNumber = 1;
+    private static readonly pb::FieldCodec<global::Client.Packets.HiscoreMessage> _repeated_hiscores_codec
+        = pb::FieldCodec.ForMessage(10, global::Client.Packets.HiscoreMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.HiscoreMessage> hiscores_ = new pbc::RepeatedField<global::Client.Packets.HiscoreMessage>();
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public string Reason {
-      get { return reason_; }
-      set {
-        reason_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
-      }
+    public pbc::RepeatedField<global::Client.Packets.HiscoreMessage> Hiscores {
+      get { return hiscores_; }
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override bool Equals(object other) {
-      return Equals(other as DisconnectMessage);
+      return Equals(other as HiscoreBoardMessage);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(DisconnectMessage other) {
+    public bool Equals(HiscoreBoardMessage other) {
       if (ReferenceEquals(other, null)) {
         return false;
       }
       if (ReferenceEquals(other, this)) {
         return true;
       }
-      if (Reason != other.Reason) return false;
+      if(!hiscores_.Equals(other.hiscores_)) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -3964,7 +4355,7 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (Reason.Length != 0) hash ^= Reason.GetHashCode();
+      hash ^= hiscores_.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -3983,10 +4374,7 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (Reason.Length != 0) {
-        output.WriteRawTag(10);
-        output.WriteString(Reason);
-      }
+      hiscores_.WriteTo(output, _repeated_hiscores_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
       }
@@ -3997,10 +4385,7 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (Reason.Length != 0) {
-        output.WriteRawTag(10);
-        output.WriteString(Reason);
-      }
+      hiscores_.WriteTo(ref output, _repeated_hiscores_codec);
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
       }
@@ -4011,9 +4396,7 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (Reason.Length != 0) {
-        size += 1 + pb::CodedOutputStream.ComputeStringSize(Reason);
-      }
+      size += hiscores_.CalculateSize(_repeated_hiscores_codec);
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
       }
@@ -4022,13 +4405,11 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(DisconnectMessage other) {
+    public void MergeFrom(HiscoreBoardMessage other) {
       if (other == null) {
         return;
       }
-      if (other.Reason.Length != 0) {
-        Reason = other.Reason;
-      }
+      hiscores_.Add(other.hiscores_);
       _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
@@ -4049,7 +4430,7 @@ namespace Client.Packets {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
             break;
           case 10: {
-            Reason = input.ReadString();
+            hiscores_.AddEntriesFrom(input, _repeated_hiscores_codec);
             break;
           }
         }
@@ -4072,7 +4453,7 @@ namespace Client.Packets {
             _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
           case 10: {
-            Reason = input.ReadString();
+            hiscores_.AddEntriesFrom(ref input, _repeated_hiscores_codec);
             break;
           }
         }
@@ -4083,16 +4464,16 @@ namespace Client.Packets {
   }
 
   [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
-  public sealed partial class Packet : pb::IMessage<Packet>
+  public sealed partial class FinishedBrowsingHiscoresMessage : pb::IMessage<FinishedBrowsingHiscoresMessage>
   #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       , pb::IBufferMessage
   #endif
   {
-    private static readonly pb::MessageParser<Packet> _parser = new pb::MessageParser<Packet>(() => new Packet());
+    private static readonly pb::MessageParser<FinishedBrowsingHiscoresMessage> _parser = new pb::MessageParser<FinishedBrowsingHiscoresMessage>(() => new FinishedBrowsingHiscoresMessage());
     private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public static pb::MessageParser<Packet> Parser { get { return _parser; } }
+    public static pb::MessageParser<FinishedBrowsingHiscoresMessage> Parser { get { return _parser; } }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
@@ -4108,7 +4489,7 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public Packet() {
+    public FinishedBrowsingHiscoresMessage() {
       OnConstruction();
     }
 
@@ -4116,374 +4497,404 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public Packet(Packet other) : this() {
-      senderId_ = other.senderId_;
-      switch (other.MsgCase) {
-        case MsgOneofCase.Chat:
-          Chat = other.Chat.Clone();
-          break;
-        case MsgOneofCase.Id:
-          Id = other.Id.Clone();
-          break;
-        case MsgOneofCase.LoginRequest:
-          LoginRequest = other.LoginRequest.Clone();
-          break;
-        case MsgOneofCase.RegisterRequest:
-          RegisterRequest = other.RegisterRequest.Clone();
-          break;
-        case MsgOneofCase.OkResponse:
-          OkResponse = other.OkResponse.Clone();
-          break;
-        case MsgOneofCase.DenyResponse:
-          DenyResponse = other.DenyResponse.Clone();
-          break;
-        case MsgOneofCase.Player:
-          Player = other.Player.Clone();
-          break;
-        case MsgOneofCase.PlayerDirection:
-          PlayerDirection = other.PlayerDirection.Clone();
-          break;
-        case MsgOneofCase.Spore:
-          Spore = other.Spore.Clone();
-          break;
-        case MsgOneofCase.SporeConsumed:
-          SporeConsumed = other.SporeConsumed.Clone();
-          break;
-        case MsgOneofCase.SporeBatch:
-          SporeBatch = other.SporeBatch.Clone();
-          break;
-        case MsgOneofCase.PlayerConsumed:
-          PlayerConsumed = other.PlayerConsumed.Clone();
-          break;
-        case MsgOneofCase.HiscoreBoardRequest:
-          HiscoreBoardRequest = other.HiscoreBoardRequest.Clone();
-          break;
-        case MsgOneofCase.Hiscore:
-          Hiscore = other.Hiscore.Clone();
-          break;
-        case MsgOneofCase.HiscoreBoard:
-          HiscoreBoard = other.HiscoreBoard.Clone();
-          break;
-        case MsgOneofCase.FinishBrowsingHiscores:
-          FinishBrowsingHiscores = other.FinishBrowsingHiscores.Clone();
-          break;
-        case MsgOneofCase.SearchHiscore:
-          SearchHiscore = other.SearchHiscore.Clone();
-          break;
-        case MsgOneofCase.Disconnect:
-          Disconnect = other.Disconnect.Clone();
-          break;
-      }
-
+    public FinishedBrowsingHiscoresMessage(FinishedBrowsingHiscoresMessage other) : this() {
       _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public Packet Clone() {
-      return new Packet(this);
+    public FinishedBrowsingHiscoresMessage Clone() {
+      return new FinishedBrowsingHiscoresMessage(this);
     }
 
-    /// <summary>Field number for the "sender_id" field.</summary>
-    public const int SenderIdFieldNumber = 1;
-    private ulong senderId_;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public ulong SenderId {
-      get { return senderId_; }
-      set {
-        senderId_ = value;
-      }
+    public override bool Equals(object other) {
+      return Equals(other as FinishedBrowsingHiscoresMessage);
     }
 
-    /// <summary>Field number for the "chat" field.</summary>
-    public const int ChatFieldNumber = 2;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.ChatMessage Chat {
-      get { return msgCase_ == MsgOneofCase.Chat ? (global::Client.Packets.ChatMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Chat;
+    public bool Equals(FinishedBrowsingHiscoresMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
       }
+      return Equals(_unknownFields, other._unknownFields);
     }
 
-    /// <summary>Field number for the "id" field.</summary>
-    public const int IdFieldNumber = 3;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.IdMessage Id {
-      get { return msgCase_ == MsgOneofCase.Id ? (global::Client.Packets.IdMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Id;
+    public override int GetHashCode() {
+      int hash = 1;
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
       }
+      return hash;
     }
 
-    /// <summary>Field number for the "login_request" field.</summary>
-    public const int LoginRequestFieldNumber = 4;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.LoginRequestMessage LoginRequest {
-      get { return msgCase_ == MsgOneofCase.LoginRequest ? (global::Client.Packets.LoginRequestMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.LoginRequest;
-      }
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
     }
 
-    /// <summary>Field number for the "register_request" field.</summary>
-    public const int RegisterRequestFieldNumber = 5;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.RegisterRequestMessage RegisterRequest {
-      get { return msgCase_ == MsgOneofCase.RegisterRequest ? (global::Client.Packets.RegisterRequestMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RegisterRequest;
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
       }
+    #endif
     }
 
-    /// <summary>Field number for the "ok_response" field.</summary>
-    public const int OkResponseFieldNumber = 6;
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.OkResponseMessage OkResponse {
-      get { return msgCase_ == MsgOneofCase.OkResponse ? (global::Client.Packets.OkResponseMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.OkResponse;
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
       }
     }
+    #endif
 
-    /// <summary>Field number for the "deny_response" field.</summary>
-    public const int DenyResponseFieldNumber = 7;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.DenyResponseMessage DenyResponse {
-      get { return msgCase_ == MsgOneofCase.DenyResponse ? (global::Client.Packets.DenyResponseMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.DenyResponse;
+    public int CalculateSize() {
+      int size = 0;
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
       }
+      return size;
     }
 
-    /// <summary>Field number for the "player" field.</summary>
-    public const int PlayerFieldNumber = 8;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.PlayerMessage Player {
-      get { return msgCase_ == MsgOneofCase.Player ? (global::Client.Packets.PlayerMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Player;
+    public void MergeFrom(FinishedBrowsingHiscoresMessage other) {
+      if (other == null) {
+        return;
       }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
     }
 
-    /// <summary>Field number for the "player_direction" field.</summary>
-    public const int PlayerDirectionFieldNumber = 9;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.PlayerDirectionMessage PlayerDirection {
-      get { return msgCase_ == MsgOneofCase.PlayerDirection ? (global::Client.Packets.PlayerDirectionMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PlayerDirection;
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+        }
       }
+    #endif
     }
 
-    /// <summary>Field number for the "spore" field.</summary>
-    public const int SporeFieldNumber = 10;
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.SporeMessage Spore {
-      get { return msgCase_ == MsgOneofCase.Spore ? (global::Client.Packets.SporeMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Spore;
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+        }
       }
     }
+    #endif
 
-    /// <summary>Field number for the "spore_consumed" field.</summary>
-    public const int SporeConsumedFieldNumber = 11;
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class SearchHiscoreMessage : pb::IMessage<SearchHiscoreMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<SearchHiscoreMessage> _parser = new pb::MessageParser<SearchHiscoreMessage>(() => new SearchHiscoreMessage());
+    private pb::UnknownFieldSet _unknownFields;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.SporeConsumedMessage SporeConsumed {
-      get { return msgCase_ == MsgOneofCase.SporeConsumed ? (global::Client.Packets.SporeConsumedMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SporeConsumed;
-      }
+    public static pb::MessageParser<SearchHiscoreMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[19]; }
     }
 
-    /// <summary>Field number for the "spore_batch" field.</summary>
-    public const int SporeBatchFieldNumber = 12;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.SporeBatchMessage SporeBatch {
-      get { return msgCase_ == MsgOneofCase.SporeBatch ? (global::Client.Packets.SporeBatchMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SporeBatch;
-      }
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
     }
 
-    /// <summary>Field number for the "player_consumed" field.</summary>
-    public const int PlayerConsumedFieldNumber = 13;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.PlayerConsumedMessage PlayerConsumed {
-      get { return msgCase_ == MsgOneofCase.PlayerConsumed ? (global::Client.Packets.PlayerConsumedMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PlayerConsumed;
-      }
+    public SearchHiscoreMessage() {
+      OnConstruction();
     }
 
-    /// <summary>Field number for the "hiscore_board_request" field.</summary>
-    public const int HiscoreBoardRequestFieldNumber = 14;
+    partial void OnConstruction();
+
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.HiscoreBoardRequestMessage HiscoreBoardRequest {
-      get { return msgCase_ == MsgOneofCase.HiscoreBoardRequest ? (global::Client.Packets.HiscoreBoardRequestMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.HiscoreBoardRequest;
-      }
+    public SearchHiscoreMessage(SearchHiscoreMessage other) : this() {
+      name_ = other.name_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
     }
 
-    /// <summary>Field number for the "hiscore" field.</summary>
-    public const int HiscoreFieldNumber = 15;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.HiscoreMessage Hiscore {
-      get { return msgCase_ == MsgOneofCase.Hiscore ? (global::Client.Packets.HiscoreMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Hiscore;
-      }
+    public SearchHiscoreMessage Clone() {
+      return new SearchHiscoreMessage(this);
     }
 
-    /// <summary>Field number for the "hiscore_board" field.</summary>
-    public const int HiscoreBoardFieldNumber = 16;
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 1;
+    private string name_ = "";
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.HiscoreBoardMessage HiscoreBoard {
-      get { return msgCase_ == MsgOneofCase.HiscoreBoard ? (global::Client.Packets.HiscoreBoardMessage) msg_ : null; }
+    public string Name {
+      get { return name_; }
       set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.HiscoreBoard;
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
       }
     }
 
-    /// <summary>Field number for the "finish_browsing_hiscores" field.</summary>
-    public const int FinishBrowsingHiscoresFieldNumber = 17;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.FinishedBrowsingHiscoresMessage FinishBrowsingHiscores {
-      get { return msgCase_ == MsgOneofCase.FinishBrowsingHiscores ? (global::Client.Packets.FinishedBrowsingHiscoresMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.FinishBrowsingHiscores;
-      }
+    public override bool Equals(object other) {
+      return Equals(other as SearchHiscoreMessage);
     }
 
-    /// <summary>Field number for the "search_hiscore" field.</summary>
-    public const int SearchHiscoreFieldNumber = 18;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.SearchHiscoreMessage SearchHiscore {
-      get { return msgCase_ == MsgOneofCase.SearchHiscore ? (global::Client.Packets.SearchHiscoreMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SearchHiscore;
+    public bool Equals(SearchHiscoreMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
       }
+      if (Name != other.Name) return false;
+      return Equals(_unknownFields, other._unknownFields);
     }
 
-    /// <summary>Field number for the "disconnect" field.</summary>
-    public const int DisconnectFieldNumber = 19;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public global::Client.Packets.DisconnectMessage Disconnect {
-      get { return msgCase_ == MsgOneofCase.Disconnect ? (global::Client.Packets.DisconnectMessage) msg_ : null; }
-      set {
-        msg_ = value;
-        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Disconnect;
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Name.Length != 0) hash ^= Name.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
       }
+      return hash;
     }
 
-    private object msg_;
-    /// <summary>Enum of possible cases for the "msg" oneof.</summary>
-    public enum MsgOneofCase {
-      None = 0,
-      Chat = 2,
-      Id = 3,
-      LoginRequest = 4,
-      RegisterRequest = 5,
-      OkResponse = 6,
-      DenyResponse = 7,
-      Player = 8,
-      PlayerDirection = 9,
-      Spore = 10,
-      SporeConsumed = 11,
-      SporeBatch = 12,
-      PlayerConsumed = 13,
-      HiscoreBoardRequest = 14,
-      Hiscore = 15,
-      HiscoreBoard = 16,
-      FinishBrowsingHiscores = 17,
-      SearchHiscore = 18,
-      Disconnect = 19,
-    }
-    private MsgOneofCase msgCase_ = MsgOneofCase.None;
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public MsgOneofCase MsgCase {
-      get { return msgCase_; }
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
     }
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void ClearMsg() {
-      msgCase_ = MsgOneofCase.None;
-      msg_ = null;
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Name.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Name);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
     }
 
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public override bool Equals(object other) {
-      return Equals(other as Packet);
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Name.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Name);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
     }
+    #endif
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public bool Equals(Packet other) {
-      if (ReferenceEquals(other, null)) {
-        return false;
+    public int CalculateSize() {
+      int size = 0;
+      if (Name.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
       }
-      if (ReferenceEquals(other, this)) {
-        return true;
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
       }
-      if (SenderId != other.SenderId) return false;
-      if (!object.Equals(Chat, other.Chat)) return false;
-      if (!object.Equals(Id, other.Id)) return false;
-      if (!object.Equals(LoginRequest, other.LoginRequest)) return false;
-      if (!object.Equals(RegisterRequest, other.RegisterRequest)) return false;
-      if (!object.Equals(OkResponse, other.OkResponse)) return false;
-      if (!object.Equals(DenyResponse, other.DenyResponse)) return false;
-      if (!object.Equals(Player, other.Player)) return false;
-      if (!object.Equals(PlayerDirection, other.PlayerDirection)) return false;
-      if (!object.Equals(Spore, other.Spore)) return false;
-      if (!object.Equals(SporeConsumed, other.SporeConsumed)) return false;
-      if (!object.Equals(SporeBatch, other.SporeBatch)) return false;
-      if (!object.Equals(PlayerConsumed, other.PlayerConsumed)) return false;
-      if (!object.Equals(HiscoreBoardRequest, other.HiscoreBoardRequest)) return false;
-      if (!object.Equals(Hiscore, other.Hiscore)) return false;
-      if (!object.Equals(HiscoreBoard, other.HiscoreBoard)) return false;
-      if (!object.Equals(FinishBrowsingHiscores, other.FinishBrowsingHiscores)) return false;
-      if (!object.Equals(SearchHiscore, other.SearchHiscore)) return false;
-      if (!object.Equals(Disconnect, other.Disconnect)) return false;
-      if (MsgCase != other.MsgCase) return false;
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(SearchHiscoreMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Name.Length != 0) {
+        Name = other.Name;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Name = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Name = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class DisconnectMessage : pb::IMessage<DisconnectMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<DisconnectMessage> _parser = new pb::MessageParser<DisconnectMessage>(() => new DisconnectMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<DisconnectMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[20]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DisconnectMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DisconnectMessage(DisconnectMessage other) : this() {
+      reason_ = other.reason_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DisconnectMessage Clone() {
+      return new DisconnectMessage(this);
+    }
+
+    /// <summary>Field number for the "reason" field.</summary>
+    public const int ReasonFieldNumber = 1;
+    private string reason_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Reason {
+      get { return reason_; }
+      set {
+        reason_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as DisconnectMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(DisconnectMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Reason != other.Reason) return false;
       return Equals(_unknownFields, other._unknownFields);
     }
 
@@ -4491,26 +4902,7 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public override int GetHashCode() {
       int hash = 1;
-      if (SenderId != 0UL) hash ^= SenderId.GetHashCode();
-      if (msgCase_ == MsgOneofCase.Chat) hash ^= Chat.GetHashCode();
-      if (msgCase_ == MsgOneofCase.Id) hash ^= Id.GetHashCode();
-      if (msgCase_ == MsgOneofCase.LoginRequest) hash ^= LoginRequest.GetHashCode();
-      if (msgCase_ == MsgOneofCase.RegisterRequest) hash ^= RegisterRequest.GetHashCode();
-      if (msgCase_ == MsgOneofCase.OkResponse) hash ^= OkResponse.GetHashCode();
-      if (msgCase_ == MsgOneofCase.DenyResponse) hash ^= DenyResponse.GetHashCode();
-      if (msgCase_ == MsgOneofCase.Player) hash ^= Player.GetHashCode();
-      if (msgCase_ == MsgOneofCase.PlayerDirection) hash ^= PlayerDirection.GetHashCode();
-      if (msgCase_ == MsgOneofCase.Spore) hash ^= Spore.GetHashCode();
-      if (msgCase_ == MsgOneofCase.SporeConsumed) hash ^= SporeConsumed.GetHashCode();
-      if (msgCase_ == MsgOneofCase.SporeBatch) hash ^= SporeBatch.GetHashCode();
-      if (msgCase_ == MsgOneofCase.PlayerConsumed) hash ^= PlayerConsumed.GetHashCode();
-      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) hash ^= HiscoreBoardRequest.GetHashCode();
-      if (msgCase_ == MsgOneofCase.Hiscore) hash ^= Hiscore.GetHashCode();
-      if (msgCase_ == MsgOneofCase.HiscoreBoard) hash ^= HiscoreBoard.GetHashCode();
-      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) hash ^= FinishBrowsingHiscores.GetHashCode();
-      if (msgCase_ == MsgOneofCase.SearchHiscore) hash ^= SearchHiscore.GetHashCode();
-      if (msgCase_ == MsgOneofCase.Disconnect) hash ^= Disconnect.GetHashCode();
-      hash ^= (int) msgCase_;
+      if (Reason.Length != 0) hash ^= Reason.GetHashCode();
       if (_unknownFields != null) {
         hash ^= _unknownFields.GetHashCode();
       }
@@ -4529,81 +4921,9 @@ namespace Client.Packets {
     #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
       output.WriteRawMessage(this);
     #else
-      if (SenderId != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(SenderId);
-      }
-      if (msgCase_ == MsgOneofCase.Chat) {
-        output.WriteRawTag(18);
-        output.WriteMessage(Chat);
-      }
-      if (msgCase_ == MsgOneofCase.Id) {
-        output.WriteRawTag(26);
-        output.WriteMessage(Id);
-      }
-      if (msgCase_ == MsgOneofCase.LoginRequest) {
-        output.WriteRawTag(34);
-        output.WriteMessage(LoginRequest);
-      }
-      if (msgCase_ == MsgOneofCase.RegisterRequest) {
-        output.WriteRawTag(42);
-        output.WriteMessage(RegisterRequest);
-      }
-      if (msgCase_ == MsgOneofCase.OkResponse) {
-        output.WriteRawTag(50);
-        output.WriteMessage(OkResponse);
-      }
-      if (msgCase_ == MsgOneofCase.DenyResponse) {
-        output.WriteRawTag(58);
-        output.WriteMessage(DenyResponse);
-      }
-      if (msgCase_ == MsgOneofCase.Player) {
-        output.WriteRawTag(66);
-        output.WriteMessage(Player);
-      }
-      if (msgCase_ == MsgOneofCase.PlayerDirection) {
-        output.WriteRawTag(74);
-        output.WriteMessage(PlayerDirection);
-      }
-      if (msgCase_ == MsgOneofCase.Spore) {
-        output.WriteRawTag(82);
-        output.WriteMessage(Spore);
-      }
-      if (msgCase_ == MsgOneofCase.SporeConsumed) {
-        output.WriteRawTag(90);
-        output.WriteMessage(SporeConsumed);
-      }
-      if (msgCase_ == MsgOneofCase.SporeBatch) {
-        output.WriteRawTag(98);
-        output.WriteMessage(SporeBatch);
-      }
-      if (msgCase_ == MsgOneofCase.PlayerConsumed) {
-        output.WriteRawTag(106);
-        output.WriteMessage(PlayerConsumed);
-      }
-      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
-        output.WriteRawTag(114);
-        output.WriteMessage(HiscoreBoardRequest);
-      }
-      if (msgCase_ == MsgOneofCase.Hiscore) {
-        output.WriteRawTag(122);
-        output.WriteMessage(Hiscore);
-      }
-      if (msgCase_ == MsgOneofCase.HiscoreBoard) {
-        output.WriteRawTag(130, 1);
-        output.WriteMessage(HiscoreBoard);
-      }
-      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
-        output.WriteRawTag(138, 1);
-        output.WriteMessage(FinishBrowsingHiscores);
-      }
-      if (msgCase_ == MsgOneofCase.SearchHiscore) {
-        output.WriteRawTag(146, 1);
-        output.WriteMessage(SearchHiscore);
-      }
-      if (msgCase_ == MsgOneofCase.Disconnect) {
-        output.WriteRawTag(154, 1);
-        output.WriteMessage(Disconnect);
+      if (Reason.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Reason);
       }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(output);
@@ -4615,81 +4935,9 @@ namespace Client.Packets {
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
-      if (SenderId != 0UL) {
-        output.WriteRawTag(8);
-        output.WriteUInt64(SenderId);
-      }
-      if (msgCase_ == MsgOneofCase.Chat) {
-        output.WriteRawTag(18);
-        output.WriteMessage(Chat);
-      }
-      if (msgCase_ == MsgOneofCase.Id) {
-        output.WriteRawTag(26);
-        output.WriteMessage(Id);
-      }
-      if (msgCase_ == MsgOneofCase.LoginRequest) {
-        output.WriteRawTag(34);
-        output.WriteMessage(LoginRequest);
-      }
-      if (msgCase_ == MsgOneofCase.RegisterRequest) {
-        output.WriteRawTag(42);
-        output.WriteMessage(RegisterRequest);
-      }
-      if (msgCase_ == MsgOneofCase.OkResponse) {
-        output.WriteRawTag(50);
-        output.WriteMessage(OkResponse);
-      }
-      if (msgCase_ == MsgOneofCase.DenyResponse) {
-        output.WriteRawTag(58);
-        output.WriteMessage(DenyResponse);
-      }
-      if (msgCase_ == MsgOneofCase.Player) {
-        output.WriteRawTag(66);
-        output.WriteMessage(Player);
-      }
-      if (msgCase_ == MsgOneofCase.PlayerDirection) {
-        output.WriteRawTag(74);
-        output.WriteMessage(PlayerDirection);
-      }
-      if (msgCase_ == MsgOneofCase.Spore) {
-        output.WriteRawTag(82);
-        output.WriteMessage(Spore);
-      }
-      if (msgCase_ == MsgOneofCase.SporeConsumed) {
-        output.WriteRawTag(90);
-        output.WriteMessage(SporeConsumed);
-      }
-      if (msgCase_ == MsgOneofCase.SporeBatch) {
-        output.WriteRawTag(98);
-        output.WriteMessage(SporeBatch);
-      }
-      if (msgCase_ == MsgOneofCase.PlayerConsumed) {
-        output.WriteRawTag(106);
-        output.WriteMessage(PlayerConsumed);
-      }
-      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
-        output.WriteRawTag(114);
-        output.WriteMessage(HiscoreBoardRequest);
-      }
-      if (msgCase_ == MsgOneofCase.Hiscore) {
-        output.WriteRawTag(122);
-        output.WriteMessage(Hiscore);
-      }
-      if (msgCase_ == MsgOneofCase.HiscoreBoard) {
-        output.WriteRawTag(130, 1);
-        output.WriteMessage(HiscoreBoard);
-      }
-      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
-        output.WriteRawTag(138, 1);
-        output.WriteMessage(FinishBrowsingHiscores);
-      }
-      if (msgCase_ == MsgOneofCase.SearchHiscore) {
-        output.WriteRawTag(146, 1);
-        output.WriteMessage(SearchHiscore);
-      }
-      if (msgCase_ == MsgOneofCase.Disconnect) {
-        output.WriteRawTag(154, 1);
-        output.WriteMessage(Disconnect);
+      if (Reason.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Reason);
       }
       if (_unknownFields != null) {
         _unknownFields.WriteTo(ref output);
@@ -4701,62 +4949,8 @@ namespace Client.Packets {
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
     public int CalculateSize() {
       int size = 0;
-      if (SenderId != 0UL) {
-        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(SenderId);
-      }
-      if (msgCase_ == MsgOneofCase.Chat) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Chat);
-      }
-      if (msgCase_ == MsgOneofCase.Id) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Id);
-      }
-      if (msgCase_ == MsgOneofCase.LoginRequest) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(LoginRequest);
-      }
-      if (msgCase_ == MsgOneofCase.RegisterRequest) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(RegisterRequest);
-      }
-      if (msgCase_ == MsgOneofCase.OkResponse) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(OkResponse);
-      }
-      if (msgCase_ == MsgOneofCase.DenyResponse) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(DenyResponse);
-      }
-      if (msgCase_ == MsgOneofCase.Player) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Player);
-      }
-      if (msgCase_ == MsgOneofCase.PlayerDirection) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(PlayerDirection);
-      }
-      if (msgCase_ == MsgOneofCase.Spore) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Spore);
-      }
-      if (msgCase_ == MsgOneofCase.SporeConsumed) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(SporeConsumed);
-      }
-      if (msgCase_ == MsgOneofCase.SporeBatch) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(SporeBatch);
-      }
-      if (msgCase_ == MsgOneofCase.PlayerConsumed) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(PlayerConsumed);
-      }
-      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(HiscoreBoardRequest);
-      }
-      if (msgCase_ == MsgOneofCase.Hiscore) {
-        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Hiscore);
-      }
-      if (msgCase_ == MsgOneofCase.HiscoreBoard) {
-        size += 2 + pb::CodedOutputStream.ComputeMessageSize(HiscoreBoard);
-      }
-      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
-        size += 2 + pb::CodedOutputStream.ComputeMessageSize(FinishBrowsingHiscores);
-      }
-      if (msgCase_ == MsgOneofCase.SearchHiscore) {
-        size += 2 + pb::CodedOutputStream.ComputeMessageSize(SearchHiscore);
-      }
-      if (msgCase_ == MsgOneofCase.Disconnect) {
-        size += 2 + pb::CodedOutputStream.ComputeMessageSize(Disconnect);
+      if (Reason.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Reason);
       }
       if (_unknownFields != null) {
         size += _unknownFields.CalculateSize();
@@ -4766,133 +4960,12376 @@ namespace Client.Packets {
 
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(Packet other) {
+    public void MergeFrom(DisconnectMessage other) {
       if (other == null) {
         return;
       }
-      if (other.SenderId != 0UL) {
-        SenderId = other.SenderId;
+      if (other.Reason.Length != 0) {
+        Reason = other.Reason;
       }
-      switch (other.MsgCase) {
-        case MsgOneofCase.Chat:
-          if (Chat == null) {
-            Chat = new global::Client.Packets.ChatMessage();
-          }
-          Chat.MergeFrom(other.Chat);
-          break;
-        case MsgOneofCase.Id:
-          if (Id == null) {
-            Id = new global::Client.Packets.IdMessage();
-          }
-          Id.MergeFrom(other.Id);
-          break;
-        case MsgOneofCase.LoginRequest:
-          if (LoginRequest == null) {
-            LoginRequest = new global::Client.Packets.LoginRequestMessage();
-          }
-          LoginRequest.MergeFrom(other.LoginRequest);
-          break;
-        case MsgOneofCase.RegisterRequest:
-          if (RegisterRequest == null) {
-            RegisterRequest = new global::Client.Packets.RegisterRequestMessage();
-          }
-          RegisterRequest.MergeFrom(other.RegisterRequest);
-          break;
-        case MsgOneofCase.OkResponse:
-          if (OkResponse == null) {
-            OkResponse = new global::Client.Packets.OkResponseMessage();
-          }
-          OkResponse.MergeFrom(other.OkResponse);
-          break;
-        case MsgOneofCase.DenyResponse:
-          if (DenyResponse == null) {
-            DenyResponse = new global::Client.Packets.DenyResponseMessage();
-          }
-          DenyResponse.MergeFrom(other.DenyResponse);
-          break;
-        case MsgOneofCase.Player:
-          if (Player == null) {
-            Player = new global::Client.Packets.PlayerMessage();
-          }
-          Player.MergeFrom(other.Player);
-          break;
-        case MsgOneofCase.PlayerDirection:
-          if (PlayerDirection == null) {
-            PlayerDirection = new global::Client.Packets.PlayerDirectionMessage();
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Reason = input.ReadString();
+            break;
           }
-          PlayerDirection.MergeFrom(other.PlayerDirection);
-          break;
-        case MsgOneofCase.Spore:
-          if (Spore == null) {
-            Spore = new global::Client.Packets.SporeMessage();
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Reason = input.ReadString();
+            break;
           }
-          Spore.MergeFrom(other.Spore);
-          break;
-        case MsgOneofCase.SporeConsumed:
-          if (SporeConsumed == null) {
-            SporeConsumed = new global::Client.Packets.SporeConsumedMessage();
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Objects that came into view, spores still in view are sent again while they're moving to update their position
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class ViewEnterMessage : pb::IMessage<ViewEnterMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<ViewEnterMessage> _parser = new pb::MessageParser<ViewEnterMessage>(() => new ViewEnterMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<ViewEnterMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[21]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ViewEnterMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ViewEnterMessage(ViewEnterMessage other) : this() {
+      spores_ = other.spores_.Clone();
+      hazards_ = other.hazards_.Clone();
+      powerUps_ = other.powerUps_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ViewEnterMessage Clone() {
+      return new ViewEnterMessage(this);
+    }
+
+    /// <summary>Field number for the "spores" field.</summary>
+    public const int SporesFieldNumber = 2;
+    private static readonly pb::FieldCodec<global::Client.Packets.SporeMessage> _repeated_spores_codec
+        = pb::FieldCodec.ForMessage(18, global::Client.Packets.SporeMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.SporeMessage> spores_ = new pbc::RepeatedField<global::Client.Packets.SporeMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.SporeMessage> Spores {
+      get { return spores_; }
+    }
+
+    /// <summary>Field number for the "hazards" field.</summary>
+    public const int HazardsFieldNumber = 3;
+    private static readonly pb::FieldCodec<global::Client.Packets.HazardMessage> _repeated_hazards_codec
+        = pb::FieldCodec.ForMessage(26, global::Client.Packets.HazardMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.HazardMessage> hazards_ = new pbc::RepeatedField<global::Client.Packets.HazardMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.HazardMessage> Hazards {
+      get { return hazards_; }
+    }
+
+    /// <summary>Field number for the "power_ups" field.</summary>
+    public const int PowerUpsFieldNumber = 4;
+    private static readonly pb::FieldCodec<global::Client.Packets.PowerUpMessage> _repeated_powerUps_codec
+        = pb::FieldCodec.ForMessage(34, global::Client.Packets.PowerUpMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.PowerUpMessage> powerUps_ = new pbc::RepeatedField<global::Client.Packets.PowerUpMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.PowerUpMessage> PowerUps {
+      get { return powerUps_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as ViewEnterMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(ViewEnterMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!spores_.Equals(other.spores_)) return false;
+      if(!hazards_.Equals(other.hazards_)) return false;
+      if(!powerUps_.Equals(other.powerUps_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= spores_.GetHashCode();
+      hash ^= hazards_.GetHashCode();
+      hash ^= powerUps_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      spores_.WriteTo(output, _repeated_spores_codec);
+      hazards_.WriteTo(output, _repeated_hazards_codec);
+      powerUps_.WriteTo(output, _repeated_powerUps_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      spores_.WriteTo(ref output, _repeated_spores_codec);
+      hazards_.WriteTo(ref output, _repeated_hazards_codec);
+      powerUps_.WriteTo(ref output, _repeated_powerUps_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += spores_.CalculateSize(_repeated_spores_codec);
+      size += hazards_.CalculateSize(_repeated_hazards_codec);
+      size += powerUps_.CalculateSize(_repeated_powerUps_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(ViewEnterMessage other) {
+      if (other == null) {
+        return;
+      }
+      spores_.Add(other.spores_);
+      hazards_.Add(other.hazards_);
+      powerUps_.Add(other.powerUps_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 18: {
+            spores_.AddEntriesFrom(input, _repeated_spores_codec);
+            break;
+          }
+          case 26: {
+            hazards_.AddEntriesFrom(input, _repeated_hazards_codec);
+            break;
+          }
+          case 34: {
+            powerUps_.AddEntriesFrom(input, _repeated_powerUps_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 18: {
+            spores_.AddEntriesFrom(ref input, _repeated_spores_codec);
+            break;
+          }
+          case 26: {
+            hazards_.AddEntriesFrom(ref input, _repeated_hazards_codec);
+            break;
+          }
+          case 34: {
+            powerUps_.AddEntriesFrom(ref input, _repeated_powerUps_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class ViewLeaveMessage : pb::IMessage<ViewLeaveMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<ViewLeaveMessage> _parser = new pb::MessageParser<ViewLeaveMessage>(() => new ViewLeaveMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<ViewLeaveMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[22]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ViewLeaveMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ViewLeaveMessage(ViewLeaveMessage other) : this() {
+      sporeIds_ = other.sporeIds_.Clone();
+      hazardIds_ = other.hazardIds_.Clone();
+      powerUpIds_ = other.powerUpIds_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ViewLeaveMessage Clone() {
+      return new ViewLeaveMessage(this);
+    }
+
+    /// <summary>Field number for the "spore_ids" field.</summary>
+    public const int SporeIdsFieldNumber = 2;
+    private static readonly pb::FieldCodec<ulong> _repeated_sporeIds_codec
+        = pb::FieldCodec.ForUInt64(18);
+    private readonly pbc::RepeatedField<ulong> sporeIds_ = new pbc::RepeatedField<ulong>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<ulong> SporeIds {
+      get { return sporeIds_; }
+    }
+
+    /// <summary>Field number for the "hazard_ids" field.</summary>
+    public const int HazardIdsFieldNumber = 3;
+    private static readonly pb::FieldCodec<ulong> _repeated_hazardIds_codec
+        = pb::FieldCodec.ForUInt64(26);
+    private readonly pbc::RepeatedField<ulong> hazardIds_ = new pbc::RepeatedField<ulong>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<ulong> HazardIds {
+      get { return hazardIds_; }
+    }
+
+    /// <summary>Field number for the "power_up_ids" field.</summary>
+    public const int PowerUpIdsFieldNumber = 4;
+    private static readonly pb::FieldCodec<ulong> _repeated_powerUpIds_codec
+        = pb::FieldCodec.ForUInt64(34);
+    private readonly pbc::RepeatedField<ulong> powerUpIds_ = new pbc::RepeatedField<ulong>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<ulong> PowerUpIds {
+      get { return powerUpIds_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as ViewLeaveMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(ViewLeaveMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!sporeIds_.Equals(other.sporeIds_)) return false;
+      if(!hazardIds_.Equals(other.hazardIds_)) return false;
+      if(!powerUpIds_.Equals(other.powerUpIds_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= sporeIds_.GetHashCode();
+      hash ^= hazardIds_.GetHashCode();
+      hash ^= powerUpIds_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      sporeIds_.WriteTo(output, _repeated_sporeIds_codec);
+      hazardIds_.WriteTo(output, _repeated_hazardIds_codec);
+      powerUpIds_.WriteTo(output, _repeated_powerUpIds_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      sporeIds_.WriteTo(ref output, _repeated_sporeIds_codec);
+      hazardIds_.WriteTo(ref output, _repeated_hazardIds_codec);
+      powerUpIds_.WriteTo(ref output, _repeated_powerUpIds_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += sporeIds_.CalculateSize(_repeated_sporeIds_codec);
+      size += hazardIds_.CalculateSize(_repeated_hazardIds_codec);
+      size += powerUpIds_.CalculateSize(_repeated_powerUpIds_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(ViewLeaveMessage other) {
+      if (other == null) {
+        return;
+      }
+      sporeIds_.Add(other.sporeIds_);
+      hazardIds_.Add(other.hazardIds_);
+      powerUpIds_.Add(other.powerUpIds_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 18:
+          case 16: {
+            sporeIds_.AddEntriesFrom(input, _repeated_sporeIds_codec);
+            break;
+          }
+          case 26:
+          case 24: {
+            hazardIds_.AddEntriesFrom(input, _repeated_hazardIds_codec);
+            break;
+          }
+          case 34:
+          case 32: {
+            powerUpIds_.AddEntriesFrom(input, _repeated_powerUpIds_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 18:
+          case 16: {
+            sporeIds_.AddEntriesFrom(ref input, _repeated_sporeIds_codec);
+            break;
+          }
+          case 26:
+          case 24: {
+            hazardIds_.AddEntriesFrom(ref input, _repeated_hazardIds_codec);
+            break;
+          }
+          case 34:
+          case 32: {
+            powerUpIds_.AddEntriesFrom(ref input, _repeated_powerUpIds_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class HazardMessage : pb::IMessage<HazardMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<HazardMessage> _parser = new pb::MessageParser<HazardMessage>(() => new HazardMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<HazardMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[23]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HazardMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HazardMessage(HazardMessage other) : this() {
+      id_ = other.id_;
+      x_ = other.x_;
+      y_ = other.y_;
+      radius_ = other.radius_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HazardMessage Clone() {
+      return new HazardMessage(this);
+    }
+
+    /// <summary>Field number for the "id" field.</summary>
+    public const int IdFieldNumber = 1;
+    private ulong id_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Id {
+      get { return id_; }
+      set {
+        id_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "x" field.</summary>
+    public const int XFieldNumber = 2;
+    private double x_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double X {
+      get { return x_; }
+      set {
+        x_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "y" field.</summary>
+    public const int YFieldNumber = 3;
+    private double y_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Y {
+      get { return y_; }
+      set {
+        y_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "radius" field.</summary>
+    public const int RadiusFieldNumber = 4;
+    private double radius_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Radius {
+      get { return radius_; }
+      set {
+        radius_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as HazardMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(HazardMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Id != other.Id) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(X, other.X)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Y, other.Y)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Radius, other.Radius)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Id != 0UL) hash ^= Id.GetHashCode();
+      if (X != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(X);
+      if (Y != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Y);
+      if (Radius != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Radius);
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Radius);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Radius);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Id != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Id);
+      }
+      if (X != 0D) {
+        size += 1 + 8;
+      }
+      if (Y != 0D) {
+        size += 1 + 8;
+      }
+      if (Radius != 0D) {
+        size += 1 + 8;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(HazardMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Id != 0UL) {
+        Id = other.Id;
+      }
+      if (other.X != 0D) {
+        X = other.X;
+      }
+      if (other.Y != 0D) {
+        Y = other.Y;
+      }
+      if (other.Radius != 0D) {
+        Radius = other.Radius;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 17: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Radius = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 17: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Radius = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// The hazard burst the player who ran into it, and is gone
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class HazardBurstMessage : pb::IMessage<HazardBurstMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<HazardBurstMessage> _parser = new pb::MessageParser<HazardBurstMessage>(() => new HazardBurstMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<HazardBurstMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[24]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HazardBurstMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HazardBurstMessage(HazardBurstMessage other) : this() {
+      hazardId_ = other.hazardId_;
+      playerId_ = other.playerId_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public HazardBurstMessage Clone() {
+      return new HazardBurstMessage(this);
+    }
+
+    /// <summary>Field number for the "hazard_id" field.</summary>
+    public const int HazardIdFieldNumber = 1;
+    private ulong hazardId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong HazardId {
+      get { return hazardId_; }
+      set {
+        hazardId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 2;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as HazardBurstMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(HazardBurstMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (HazardId != other.HazardId) return false;
+      if (PlayerId != other.PlayerId) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (HazardId != 0UL) hash ^= HazardId.GetHashCode();
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (HazardId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(HazardId);
+      }
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(PlayerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (HazardId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(HazardId);
+      }
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(PlayerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (HazardId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(HazardId);
+      }
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(HazardBurstMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.HazardId != 0UL) {
+        HazardId = other.HazardId;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            HazardId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            HazardId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class PowerUpMessage : pb::IMessage<PowerUpMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<PowerUpMessage> _parser = new pb::MessageParser<PowerUpMessage>(() => new PowerUpMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<PowerUpMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[25]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpMessage(PowerUpMessage other) : this() {
+      id_ = other.id_;
+      x_ = other.x_;
+      y_ = other.y_;
+      radius_ = other.radius_;
+      kind_ = other.kind_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpMessage Clone() {
+      return new PowerUpMessage(this);
+    }
+
+    /// <summary>Field number for the "id" field.</summary>
+    public const int IdFieldNumber = 1;
+    private ulong id_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Id {
+      get { return id_; }
+      set {
+        id_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "x" field.</summary>
+    public const int XFieldNumber = 2;
+    private double x_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double X {
+      get { return x_; }
+      set {
+        x_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "y" field.</summary>
+    public const int YFieldNumber = 3;
+    private double y_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Y {
+      get { return y_; }
+      set {
+        y_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "radius" field.</summary>
+    public const int RadiusFieldNumber = 4;
+    private double radius_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Radius {
+      get { return radius_; }
+      set {
+        radius_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "kind" field.</summary>
+    public const int KindFieldNumber = 5;
+    private global::Client.Packets.PowerUpKind kind_ = global::Client.Packets.PowerUpKind.PowerUpNone;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PowerUpKind Kind {
+      get { return kind_; }
+      set {
+        kind_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as PowerUpMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(PowerUpMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Id != other.Id) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(X, other.X)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Y, other.Y)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Radius, other.Radius)) return false;
+      if (Kind != other.Kind) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Id != 0UL) hash ^= Id.GetHashCode();
+      if (X != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(X);
+      if (Y != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Y);
+      if (Radius != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Radius);
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) hash ^= Kind.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Radius);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        output.WriteRawTag(40);
+        output.WriteEnum((int) Kind);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Radius);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        output.WriteRawTag(40);
+        output.WriteEnum((int) Kind);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Id != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Id);
+      }
+      if (X != 0D) {
+        size += 1 + 8;
+      }
+      if (Y != 0D) {
+        size += 1 + 8;
+      }
+      if (Radius != 0D) {
+        size += 1 + 8;
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        size += 1 + pb::CodedOutputStream.ComputeEnumSize((int) Kind);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(PowerUpMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Id != 0UL) {
+        Id = other.Id;
+      }
+      if (other.X != 0D) {
+        X = other.X;
+      }
+      if (other.Y != 0D) {
+        Y = other.Y;
+      }
+      if (other.Radius != 0D) {
+        Radius = other.Radius;
+      }
+      if (other.Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        Kind = other.Kind;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 17: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 40: {
+            Kind = (global::Client.Packets.PowerUpKind) input.ReadEnum();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 17: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 40: {
+            Kind = (global::Client.Packets.PowerUpKind) input.ReadEnum();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class PowerUpCollectedMessage : pb::IMessage<PowerUpCollectedMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<PowerUpCollectedMessage> _parser = new pb::MessageParser<PowerUpCollectedMessage>(() => new PowerUpCollectedMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<PowerUpCollectedMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[26]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpCollectedMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpCollectedMessage(PowerUpCollectedMessage other) : this() {
+      powerUpId_ = other.powerUpId_;
+      playerId_ = other.playerId_;
+      kind_ = other.kind_;
+      durationMs_ = other.durationMs_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpCollectedMessage Clone() {
+      return new PowerUpCollectedMessage(this);
+    }
+
+    /// <summary>Field number for the "power_up_id" field.</summary>
+    public const int PowerUpIdFieldNumber = 1;
+    private ulong powerUpId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PowerUpId {
+      get { return powerUpId_; }
+      set {
+        powerUpId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 2;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "kind" field.</summary>
+    public const int KindFieldNumber = 3;
+    private global::Client.Packets.PowerUpKind kind_ = global::Client.Packets.PowerUpKind.PowerUpNone;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PowerUpKind Kind {
+      get { return kind_; }
+      set {
+        kind_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "duration_ms" field.</summary>
+    public const int DurationMsFieldNumber = 4;
+    private uint durationMs_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint DurationMs {
+      get { return durationMs_; }
+      set {
+        durationMs_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as PowerUpCollectedMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(PowerUpCollectedMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PowerUpId != other.PowerUpId) return false;
+      if (PlayerId != other.PlayerId) return false;
+      if (Kind != other.Kind) return false;
+      if (DurationMs != other.DurationMs) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PowerUpId != 0UL) hash ^= PowerUpId.GetHashCode();
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) hash ^= Kind.GetHashCode();
+      if (DurationMs != 0) hash ^= DurationMs.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PowerUpId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PowerUpId);
+      }
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        output.WriteRawTag(24);
+        output.WriteEnum((int) Kind);
+      }
+      if (DurationMs != 0) {
+        output.WriteRawTag(32);
+        output.WriteUInt32(DurationMs);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PowerUpId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PowerUpId);
+      }
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        output.WriteRawTag(24);
+        output.WriteEnum((int) Kind);
+      }
+      if (DurationMs != 0) {
+        output.WriteRawTag(32);
+        output.WriteUInt32(DurationMs);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PowerUpId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PowerUpId);
+      }
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        size += 1 + pb::CodedOutputStream.ComputeEnumSize((int) Kind);
+      }
+      if (DurationMs != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(DurationMs);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(PowerUpCollectedMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PowerUpId != 0UL) {
+        PowerUpId = other.PowerUpId;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      if (other.Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        Kind = other.Kind;
+      }
+      if (other.DurationMs != 0) {
+        DurationMs = other.DurationMs;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            PowerUpId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 24: {
+            Kind = (global::Client.Packets.PowerUpKind) input.ReadEnum();
+            break;
+          }
+          case 32: {
+            DurationMs = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            PowerUpId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 24: {
+            Kind = (global::Client.Packets.PowerUpKind) input.ReadEnum();
+            break;
+          }
+          case 32: {
+            DurationMs = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class PowerUpExpiredMessage : pb::IMessage<PowerUpExpiredMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<PowerUpExpiredMessage> _parser = new pb::MessageParser<PowerUpExpiredMessage>(() => new PowerUpExpiredMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<PowerUpExpiredMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[27]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpExpiredMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpExpiredMessage(PowerUpExpiredMessage other) : this() {
+      playerId_ = other.playerId_;
+      kind_ = other.kind_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PowerUpExpiredMessage Clone() {
+      return new PowerUpExpiredMessage(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "kind" field.</summary>
+    public const int KindFieldNumber = 2;
+    private global::Client.Packets.PowerUpKind kind_ = global::Client.Packets.PowerUpKind.PowerUpNone;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PowerUpKind Kind {
+      get { return kind_; }
+      set {
+        kind_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as PowerUpExpiredMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(PowerUpExpiredMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      if (Kind != other.Kind) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) hash ^= Kind.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        output.WriteRawTag(16);
+        output.WriteEnum((int) Kind);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        output.WriteRawTag(16);
+        output.WriteEnum((int) Kind);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        size += 1 + pb::CodedOutputStream.ComputeEnumSize((int) Kind);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(PowerUpExpiredMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      if (other.Kind != global::Client.Packets.PowerUpKind.PowerUpNone) {
+        Kind = other.Kind;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            Kind = (global::Client.Packets.PowerUpKind) input.ReadEnum();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            Kind = (global::Client.Packets.PowerUpKind) input.ReadEnum();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Only the fields that changed since the baseline are set, every field is set for players new to the baseline
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class PlayerDeltaMessage : pb::IMessage<PlayerDeltaMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<PlayerDeltaMessage> _parser = new pb::MessageParser<PlayerDeltaMessage>(() => new PlayerDeltaMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    private int _hasBits0;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<PlayerDeltaMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[28]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PlayerDeltaMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PlayerDeltaMessage(PlayerDeltaMessage other) : this() {
+      _hasBits0 = other._hasBits0;
+      id_ = other.id_;
+      name_ = other.name_;
+      x_ = other.x_;
+      y_ = other.y_;
+      radius_ = other.radius_;
+      direction_ = other.direction_;
+      speed_ = other.speed_;
+      color_ = other.color_;
+      team_ = other.team_;
+      velocityX_ = other.velocityX_;
+      velocityY_ = other.velocityY_;
+      spawnProtected_ = other.spawnProtected_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public PlayerDeltaMessage Clone() {
+      return new PlayerDeltaMessage(this);
+    }
+
+    /// <summary>Field number for the "id" field.</summary>
+    public const int IdFieldNumber = 1;
+    private ulong id_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Id {
+      get { return id_; }
+      set {
+        id_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 2;
+    private readonly static string NameDefaultValue = "";
+
+    private string name_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Name {
+      get { return name_ ?? NameDefaultValue; }
+      set {
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+    /// <summary>Gets whether the "name" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasName {
+      get { return name_ != null; }
+    }
+    /// <summary>Clears the value of the "name" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearName() {
+      name_ = null;
+    }
+
+    /// <summary>Field number for the "x" field.</summary>
+    public const int XFieldNumber = 3;
+    private readonly static double XDefaultValue = 0D;
+
+    private double x_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double X {
+      get { if ((_hasBits0 & 1) != 0) { return x_; } else { return XDefaultValue; } }
+      set {
+        _hasBits0 |= 1;
+        x_ = value;
+      }
+    }
+    /// <summary>Gets whether the "x" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasX {
+      get { return (_hasBits0 & 1) != 0; }
+    }
+    /// <summary>Clears the value of the "x" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearX() {
+      _hasBits0 &= ~1;
+    }
+
+    /// <summary>Field number for the "y" field.</summary>
+    public const int YFieldNumber = 4;
+    private readonly static double YDefaultValue = 0D;
+
+    private double y_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Y {
+      get { if ((_hasBits0 & 2) != 0) { return y_; } else { return YDefaultValue; } }
+      set {
+        _hasBits0 |= 2;
+        y_ = value;
+      }
+    }
+    /// <summary>Gets whether the "y" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasY {
+      get { return (_hasBits0 & 2) != 0; }
+    }
+    /// <summary>Clears the value of the "y" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearY() {
+      _hasBits0 &= ~2;
+    }
+
+    /// <summary>Field number for the "radius" field.</summary>
+    public const int RadiusFieldNumber = 5;
+    private readonly static double RadiusDefaultValue = 0D;
+
+    private double radius_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Radius {
+      get { if ((_hasBits0 & 4) != 0) { return radius_; } else { return RadiusDefaultValue; } }
+      set {
+        _hasBits0 |= 4;
+        radius_ = value;
+      }
+    }
+    /// <summary>Gets whether the "radius" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasRadius {
+      get { return (_hasBits0 & 4) != 0; }
+    }
+    /// <summary>Clears the value of the "radius" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearRadius() {
+      _hasBits0 &= ~4;
+    }
+
+    /// <summary>Field number for the "direction" field.</summary>
+    public const int DirectionFieldNumber = 6;
+    private readonly static double DirectionDefaultValue = 0D;
+
+    private double direction_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Direction {
+      get { if ((_hasBits0 & 8) != 0) { return direction_; } else { return DirectionDefaultValue; } }
+      set {
+        _hasBits0 |= 8;
+        direction_ = value;
+      }
+    }
+    /// <summary>Gets whether the "direction" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasDirection {
+      get { return (_hasBits0 & 8) != 0; }
+    }
+    /// <summary>Clears the value of the "direction" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearDirection() {
+      _hasBits0 &= ~8;
+    }
+
+    /// <summary>Field number for the "speed" field.</summary>
+    public const int SpeedFieldNumber = 7;
+    private readonly static double SpeedDefaultValue = 0D;
+
+    private double speed_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Speed {
+      get { if ((_hasBits0 & 16) != 0) { return speed_; } else { return SpeedDefaultValue; } }
+      set {
+        _hasBits0 |= 16;
+        speed_ = value;
+      }
+    }
+    /// <summary>Gets whether the "speed" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasSpeed {
+      get { return (_hasBits0 & 16) != 0; }
+    }
+    /// <summary>Clears the value of the "speed" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearSpeed() {
+      _hasBits0 &= ~16;
+    }
+
+    /// <summary>Field number for the "color" field.</summary>
+    public const int ColorFieldNumber = 8;
+    private readonly static uint ColorDefaultValue = 0;
+
+    private uint color_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Color {
+      get { if ((_hasBits0 & 32) != 0) { return color_; } else { return ColorDefaultValue; } }
+      set {
+        _hasBits0 |= 32;
+        color_ = value;
+      }
+    }
+    /// <summary>Gets whether the "color" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasColor {
+      get { return (_hasBits0 & 32) != 0; }
+    }
+    /// <summary>Clears the value of the "color" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearColor() {
+      _hasBits0 &= ~32;
+    }
+
+    /// <summary>Field number for the "team" field.</summary>
+    public const int TeamFieldNumber = 9;
+    private readonly static uint TeamDefaultValue = 0;
+
+    private uint team_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Team {
+      get { if ((_hasBits0 & 64) != 0) { return team_; } else { return TeamDefaultValue; } }
+      set {
+        _hasBits0 |= 64;
+        team_ = value;
+      }
+    }
+    /// <summary>Gets whether the "team" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasTeam {
+      get { return (_hasBits0 & 64) != 0; }
+    }
+    /// <summary>Clears the value of the "team" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearTeam() {
+      _hasBits0 &= ~64;
+    }
+
+    /// <summary>Field number for the "velocity_x" field.</summary>
+    public const int VelocityXFieldNumber = 10;
+    private readonly static double VelocityXDefaultValue = 0D;
+
+    private double velocityX_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double VelocityX {
+      get { if ((_hasBits0 & 128) != 0) { return velocityX_; } else { return VelocityXDefaultValue; } }
+      set {
+        _hasBits0 |= 128;
+        velocityX_ = value;
+      }
+    }
+    /// <summary>Gets whether the "velocity_x" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasVelocityX {
+      get { return (_hasBits0 & 128) != 0; }
+    }
+    /// <summary>Clears the value of the "velocity_x" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearVelocityX() {
+      _hasBits0 &= ~128;
+    }
+
+    /// <summary>Field number for the "velocity_y" field.</summary>
+    public const int VelocityYFieldNumber = 11;
+    private readonly static double VelocityYDefaultValue = 0D;
+
+    private double velocityY_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double VelocityY {
+      get { if ((_hasBits0 & 256) != 0) { return velocityY_; } else { return VelocityYDefaultValue; } }
+      set {
+        _hasBits0 |= 256;
+        velocityY_ = value;
+      }
+    }
+    /// <summary>Gets whether the "velocity_y" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasVelocityY {
+      get { return (_hasBits0 & 256) != 0; }
+    }
+    /// <summary>Clears the value of the "velocity_y" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearVelocityY() {
+      _hasBits0 &= ~256;
+    }
+
+    /// <summary>Field number for the "spawn_protected" field.</summary>
+    public const int SpawnProtectedFieldNumber = 12;
+    private readonly static bool SpawnProtectedDefaultValue = false;
+
+    private bool spawnProtected_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool SpawnProtected {
+      get { if ((_hasBits0 & 512) != 0) { return spawnProtected_; } else { return SpawnProtectedDefaultValue; } }
+      set {
+        _hasBits0 |= 512;
+        spawnProtected_ = value;
+      }
+    }
+    /// <summary>Gets whether the "spawn_protected" field is set</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool HasSpawnProtected {
+      get { return (_hasBits0 & 512) != 0; }
+    }
+    /// <summary>Clears the value of the "spawn_protected" field</summary>
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearSpawnProtected() {
+      _hasBits0 &= ~512;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as PlayerDeltaMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(PlayerDeltaMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Id != other.Id) return false;
+      if (Name != other.Name) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(X, other.X)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Y, other.Y)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Radius, other.Radius)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Direction, other.Direction)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Speed, other.Speed)) return false;
+      if (Color != other.Color) return false;
+      if (Team != other.Team) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(VelocityX, other.VelocityX)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(VelocityY, other.VelocityY)) return false;
+      if (SpawnProtected != other.SpawnProtected) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Id != 0UL) hash ^= Id.GetHashCode();
+      if (HasName) hash ^= Name.GetHashCode();
+      if (HasX) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(X);
+      if (HasY) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Y);
+      if (HasRadius) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Radius);
+      if (HasDirection) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Direction);
+      if (HasSpeed) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Speed);
+      if (HasColor) hash ^= Color.GetHashCode();
+      if (HasTeam) hash ^= Team.GetHashCode();
+      if (HasVelocityX) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(VelocityX);
+      if (HasVelocityY) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(VelocityY);
+      if (HasSpawnProtected) hash ^= SpawnProtected.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (HasName) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (HasX) {
+        output.WriteRawTag(25);
+        output.WriteDouble(X);
+      }
+      if (HasY) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Y);
+      }
+      if (HasRadius) {
+        output.WriteRawTag(41);
+        output.WriteDouble(Radius);
+      }
+      if (HasDirection) {
+        output.WriteRawTag(49);
+        output.WriteDouble(Direction);
+      }
+      if (HasSpeed) {
+        output.WriteRawTag(57);
+        output.WriteDouble(Speed);
+      }
+      if (HasColor) {
+        output.WriteRawTag(64);
+        output.WriteUInt32(Color);
+      }
+      if (HasTeam) {
+        output.WriteRawTag(72);
+        output.WriteUInt32(Team);
+      }
+      if (HasVelocityX) {
+        output.WriteRawTag(81);
+        output.WriteDouble(VelocityX);
+      }
+      if (HasVelocityY) {
+        output.WriteRawTag(89);
+        output.WriteDouble(VelocityY);
+      }
+      if (HasSpawnProtected) {
+        output.WriteRawTag(96);
+        output.WriteBool(SpawnProtected);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (HasName) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (HasX) {
+        output.WriteRawTag(25);
+        output.WriteDouble(X);
+      }
+      if (HasY) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Y);
+      }
+      if (HasRadius) {
+        output.WriteRawTag(41);
+        output.WriteDouble(Radius);
+      }
+      if (HasDirection) {
+        output.WriteRawTag(49);
+        output.WriteDouble(Direction);
+      }
+      if (HasSpeed) {
+        output.WriteRawTag(57);
+        output.WriteDouble(Speed);
+      }
+      if (HasColor) {
+        output.WriteRawTag(64);
+        output.WriteUInt32(Color);
+      }
+      if (HasTeam) {
+        output.WriteRawTag(72);
+        output.WriteUInt32(Team);
+      }
+      if (HasVelocityX) {
+        output.WriteRawTag(81);
+        output.WriteDouble(VelocityX);
+      }
+      if (HasVelocityY) {
+        output.WriteRawTag(89);
+        output.WriteDouble(VelocityY);
+      }
+      if (HasSpawnProtected) {
+        output.WriteRawTag(96);
+        output.WriteBool(SpawnProtected);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Id != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Id);
+      }
+      if (HasName) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
+      }
+      if (HasX) {
+        size += 1 + 8;
+      }
+      if (HasY) {
+        size += 1 + 8;
+      }
+      if (HasRadius) {
+        size += 1 + 8;
+      }
+      if (HasDirection) {
+        size += 1 + 8;
+      }
+      if (HasSpeed) {
+        size += 1 + 8;
+      }
+      if (HasColor) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Color);
+      }
+      if (HasTeam) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Team);
+      }
+      if (HasVelocityX) {
+        size += 1 + 8;
+      }
+      if (HasVelocityY) {
+        size += 1 + 8;
+      }
+      if (HasSpawnProtected) {
+        size += 1 + 1;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(PlayerDeltaMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Id != 0UL) {
+        Id = other.Id;
+      }
+      if (other.HasName) {
+        Name = other.Name;
+      }
+      if (other.HasX) {
+        X = other.X;
+      }
+      if (other.HasY) {
+        Y = other.Y;
+      }
+      if (other.HasRadius) {
+        Radius = other.Radius;
+      }
+      if (other.HasDirection) {
+        Direction = other.Direction;
+      }
+      if (other.HasSpeed) {
+        Speed = other.Speed;
+      }
+      if (other.HasColor) {
+        Color = other.Color;
+      }
+      if (other.HasTeam) {
+        Team = other.Team;
+      }
+      if (other.HasVelocityX) {
+        VelocityX = other.VelocityX;
+      }
+      if (other.HasVelocityY) {
+        VelocityY = other.VelocityY;
+      }
+      if (other.HasSpawnProtected) {
+        SpawnProtected = other.SpawnProtected;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 25: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 41: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 49: {
+            Direction = input.ReadDouble();
+            break;
+          }
+          case 57: {
+            Speed = input.ReadDouble();
+            break;
+          }
+          case 64: {
+            Color = input.ReadUInt32();
+            break;
+          }
+          case 72: {
+            Team = input.ReadUInt32();
+            break;
+          }
+          case 81: {
+            VelocityX = input.ReadDouble();
+            break;
+          }
+          case 89: {
+            VelocityY = input.ReadDouble();
+            break;
+          }
+          case 96: {
+            SpawnProtected = input.ReadBool();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 25: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 41: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 49: {
+            Direction = input.ReadDouble();
+            break;
+          }
+          case 57: {
+            Speed = input.ReadDouble();
+            break;
+          }
+          case 64: {
+            Color = input.ReadUInt32();
+            break;
+          }
+          case 72: {
+            Team = input.ReadUInt32();
+            break;
+          }
+          case 81: {
+            VelocityX = input.ReadDouble();
+            break;
+          }
+          case 89: {
+            VelocityY = input.ReadDouble();
+            break;
+          }
+          case 96: {
+            SpawnProtected = input.ReadBool();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// A piece a player split off, it belongs to the player with ID owner_id
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class CellMessage : pb::IMessage<CellMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<CellMessage> _parser = new pb::MessageParser<CellMessage>(() => new CellMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<CellMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[29]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CellMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CellMessage(CellMessage other) : this() {
+      id_ = other.id_;
+      ownerId_ = other.ownerId_;
+      x_ = other.x_;
+      y_ = other.y_;
+      radius_ = other.radius_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CellMessage Clone() {
+      return new CellMessage(this);
+    }
+
+    /// <summary>Field number for the "id" field.</summary>
+    public const int IdFieldNumber = 1;
+    private ulong id_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Id {
+      get { return id_; }
+      set {
+        id_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "owner_id" field.</summary>
+    public const int OwnerIdFieldNumber = 2;
+    private ulong ownerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong OwnerId {
+      get { return ownerId_; }
+      set {
+        ownerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "x" field.</summary>
+    public const int XFieldNumber = 3;
+    private double x_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double X {
+      get { return x_; }
+      set {
+        x_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "y" field.</summary>
+    public const int YFieldNumber = 4;
+    private double y_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Y {
+      get { return y_; }
+      set {
+        y_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "radius" field.</summary>
+    public const int RadiusFieldNumber = 5;
+    private double radius_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Radius {
+      get { return radius_; }
+      set {
+        radius_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as CellMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(CellMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Id != other.Id) return false;
+      if (OwnerId != other.OwnerId) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(X, other.X)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Y, other.Y)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Radius, other.Radius)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Id != 0UL) hash ^= Id.GetHashCode();
+      if (OwnerId != 0UL) hash ^= OwnerId.GetHashCode();
+      if (X != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(X);
+      if (Y != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Y);
+      if (Radius != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Radius);
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (OwnerId != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(OwnerId);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(41);
+        output.WriteDouble(Radius);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (OwnerId != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(OwnerId);
+      }
+      if (X != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(41);
+        output.WriteDouble(Radius);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Id != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Id);
+      }
+      if (OwnerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(OwnerId);
+      }
+      if (X != 0D) {
+        size += 1 + 8;
+      }
+      if (Y != 0D) {
+        size += 1 + 8;
+      }
+      if (Radius != 0D) {
+        size += 1 + 8;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(CellMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Id != 0UL) {
+        Id = other.Id;
+      }
+      if (other.OwnerId != 0UL) {
+        OwnerId = other.OwnerId;
+      }
+      if (other.X != 0D) {
+        X = other.X;
+      }
+      if (other.Y != 0D) {
+        Y = other.Y;
+      }
+      if (other.Radius != 0D) {
+        Radius = other.Radius;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            OwnerId = input.ReadUInt64();
+            break;
+          }
+          case 25: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 41: {
+            Radius = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            OwnerId = input.ReadUInt64();
+            break;
+          }
+          case 25: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 41: {
+            Radius = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Players in view, relative to the snapshot with sequence number baseline (0 for a keyframe).
+  /// Split off cells in view are always sent in full.
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class SnapshotMessage : pb::IMessage<SnapshotMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<SnapshotMessage> _parser = new pb::MessageParser<SnapshotMessage>(() => new SnapshotMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<SnapshotMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[30]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SnapshotMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SnapshotMessage(SnapshotMessage other) : this() {
+      sequence_ = other.sequence_;
+      baseline_ = other.baseline_;
+      players_ = other.players_.Clone();
+      removedPlayerIds_ = other.removedPlayerIds_.Clone();
+      cells_ = other.cells_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SnapshotMessage Clone() {
+      return new SnapshotMessage(this);
+    }
+
+    /// <summary>Field number for the "sequence" field.</summary>
+    public const int SequenceFieldNumber = 1;
+    private ulong sequence_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Sequence {
+      get { return sequence_; }
+      set {
+        sequence_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "baseline" field.</summary>
+    public const int BaselineFieldNumber = 2;
+    private ulong baseline_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Baseline {
+      get { return baseline_; }
+      set {
+        baseline_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "players" field.</summary>
+    public const int PlayersFieldNumber = 3;
+    private static readonly pb::FieldCodec<global::Client.Packets.PlayerDeltaMessage> _repeated_players_codec
+        = pb::FieldCodec.ForMessage(26, global::Client.Packets.PlayerDeltaMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.PlayerDeltaMessage> players_ = new pbc::RepeatedField<global::Client.Packets.PlayerDeltaMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.PlayerDeltaMessage> Players {
+      get { return players_; }
+    }
+
+    /// <summary>Field number for the "removed_player_ids" field.</summary>
+    public const int RemovedPlayerIdsFieldNumber = 4;
+    private static readonly pb::FieldCodec<ulong> _repeated_removedPlayerIds_codec
+        = pb::FieldCodec.ForUInt64(34);
+    private readonly pbc::RepeatedField<ulong> removedPlayerIds_ = new pbc::RepeatedField<ulong>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<ulong> RemovedPlayerIds {
+      get { return removedPlayerIds_; }
+    }
+
+    /// <summary>Field number for the "cells" field.</summary>
+    public const int CellsFieldNumber = 5;
+    private static readonly pb::FieldCodec<global::Client.Packets.CellMessage> _repeated_cells_codec
+        = pb::FieldCodec.ForMessage(42, global::Client.Packets.CellMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.CellMessage> cells_ = new pbc::RepeatedField<global::Client.Packets.CellMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.CellMessage> Cells {
+      get { return cells_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as SnapshotMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(SnapshotMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Sequence != other.Sequence) return false;
+      if (Baseline != other.Baseline) return false;
+      if(!players_.Equals(other.players_)) return false;
+      if(!removedPlayerIds_.Equals(other.removedPlayerIds_)) return false;
+      if(!cells_.Equals(other.cells_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Sequence != 0UL) hash ^= Sequence.GetHashCode();
+      if (Baseline != 0UL) hash ^= Baseline.GetHashCode();
+      hash ^= players_.GetHashCode();
+      hash ^= removedPlayerIds_.GetHashCode();
+      hash ^= cells_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Sequence != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Sequence);
+      }
+      if (Baseline != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(Baseline);
+      }
+      players_.WriteTo(output, _repeated_players_codec);
+      removedPlayerIds_.WriteTo(output, _repeated_removedPlayerIds_codec);
+      cells_.WriteTo(output, _repeated_cells_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Sequence != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Sequence);
+      }
+      if (Baseline != 0UL) {
+        output.WriteRawTag(16);
+        output.WriteUInt64(Baseline);
+      }
+      players_.WriteTo(ref output, _repeated_players_codec);
+      removedPlayerIds_.WriteTo(ref output, _repeated_removedPlayerIds_codec);
+      cells_.WriteTo(ref output, _repeated_cells_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Sequence != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Sequence);
+      }
+      if (Baseline != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Baseline);
+      }
+      size += players_.CalculateSize(_repeated_players_codec);
+      size += removedPlayerIds_.CalculateSize(_repeated_removedPlayerIds_codec);
+      size += cells_.CalculateSize(_repeated_cells_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(SnapshotMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Sequence != 0UL) {
+        Sequence = other.Sequence;
+      }
+      if (other.Baseline != 0UL) {
+        Baseline = other.Baseline;
+      }
+      players_.Add(other.players_);
+      removedPlayerIds_.Add(other.removedPlayerIds_);
+      cells_.Add(other.cells_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Sequence = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            Baseline = input.ReadUInt64();
+            break;
+          }
+          case 26: {
+            players_.AddEntriesFrom(input, _repeated_players_codec);
+            break;
+          }
+          case 34:
+          case 32: {
+            removedPlayerIds_.AddEntriesFrom(input, _repeated_removedPlayerIds_codec);
+            break;
+          }
+          case 42: {
+            cells_.AddEntriesFrom(input, _repeated_cells_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Sequence = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            Baseline = input.ReadUInt64();
+            break;
+          }
+          case 26: {
+            players_.AddEntriesFrom(ref input, _repeated_players_codec);
+            break;
+          }
+          case 34:
+          case 32: {
+            removedPlayerIds_.AddEntriesFrom(ref input, _repeated_removedPlayerIds_codec);
+            break;
+          }
+          case 42: {
+            cells_.AddEntriesFrom(ref input, _repeated_cells_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class SnapshotAckMessage : pb::IMessage<SnapshotAckMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<SnapshotAckMessage> _parser = new pb::MessageParser<SnapshotAckMessage>(() => new SnapshotAckMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<SnapshotAckMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[31]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SnapshotAckMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SnapshotAckMessage(SnapshotAckMessage other) : this() {
+      sequence_ = other.sequence_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SnapshotAckMessage Clone() {
+      return new SnapshotAckMessage(this);
+    }
+
+    /// <summary>Field number for the "sequence" field.</summary>
+    public const int SequenceFieldNumber = 1;
+    private ulong sequence_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Sequence {
+      get { return sequence_; }
+      set {
+        sequence_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as SnapshotAckMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(SnapshotAckMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Sequence != other.Sequence) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Sequence != 0UL) hash ^= Sequence.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Sequence != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Sequence);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Sequence != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Sequence);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Sequence != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Sequence);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(SnapshotAckMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Sequence != 0UL) {
+        Sequence = other.Sequence;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Sequence = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Sequence = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoomMessage : pb::IMessage<RoomMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoomMessage> _parser = new pb::MessageParser<RoomMessage>(() => new RoomMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoomMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[32]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomMessage(RoomMessage other) : this() {
+      id_ = other.id_;
+      name_ = other.name_;
+      players_ = other.players_;
+      capacity_ = other.capacity_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomMessage Clone() {
+      return new RoomMessage(this);
+    }
+
+    /// <summary>Field number for the "id" field.</summary>
+    public const int IdFieldNumber = 1;
+    private ulong id_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Id {
+      get { return id_; }
+      set {
+        id_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 2;
+    private string name_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Name {
+      get { return name_; }
+      set {
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "players" field.</summary>
+    public const int PlayersFieldNumber = 3;
+    private uint players_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Players {
+      get { return players_; }
+      set {
+        players_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "capacity" field.</summary>
+    public const int CapacityFieldNumber = 4;
+    private uint capacity_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Capacity {
+      get { return capacity_; }
+      set {
+        capacity_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoomMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoomMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Id != other.Id) return false;
+      if (Name != other.Name) return false;
+      if (Players != other.Players) return false;
+      if (Capacity != other.Capacity) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Id != 0UL) hash ^= Id.GetHashCode();
+      if (Name.Length != 0) hash ^= Name.GetHashCode();
+      if (Players != 0) hash ^= Players.GetHashCode();
+      if (Capacity != 0) hash ^= Capacity.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Players != 0) {
+        output.WriteRawTag(24);
+        output.WriteUInt32(Players);
+      }
+      if (Capacity != 0) {
+        output.WriteRawTag(32);
+        output.WriteUInt32(Capacity);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Id != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(Id);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Players != 0) {
+        output.WriteRawTag(24);
+        output.WriteUInt32(Players);
+      }
+      if (Capacity != 0) {
+        output.WriteRawTag(32);
+        output.WriteUInt32(Capacity);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Id != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Id);
+      }
+      if (Name.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
+      }
+      if (Players != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Players);
+      }
+      if (Capacity != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Capacity);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoomMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Id != 0UL) {
+        Id = other.Id;
+      }
+      if (other.Name.Length != 0) {
+        Name = other.Name;
+      }
+      if (other.Players != 0) {
+        Players = other.Players;
+      }
+      if (other.Capacity != 0) {
+        Capacity = other.Capacity;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Players = input.ReadUInt32();
+            break;
+          }
+          case 32: {
+            Capacity = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Id = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Players = input.ReadUInt32();
+            break;
+          }
+          case 32: {
+            Capacity = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoomListRequestMessage : pb::IMessage<RoomListRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoomListRequestMessage> _parser = new pb::MessageParser<RoomListRequestMessage>(() => new RoomListRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoomListRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[33]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomListRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomListRequestMessage(RoomListRequestMessage other) : this() {
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomListRequestMessage Clone() {
+      return new RoomListRequestMessage(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoomListRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoomListRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoomListRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoomListMessage : pb::IMessage<RoomListMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoomListMessage> _parser = new pb::MessageParser<RoomListMessage>(() => new RoomListMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoomListMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[34]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomListMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomListMessage(RoomListMessage other) : this() {
+      rooms_ = other.rooms_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomListMessage Clone() {
+      return new RoomListMessage(this);
+    }
+
+    /// <summary>Field number for the "rooms" field.</summary>
+    public const int RoomsFieldNumber = 1;
+    private static readonly pb::FieldCodec<global::Client.Packets.RoomMessage> _repeated_rooms_codec
+        = pb::FieldCodec.ForMessage(10, global::Client.Packets.RoomMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.RoomMessage> rooms_ = new pbc::RepeatedField<global::Client.Packets.RoomMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.RoomMessage> Rooms {
+      get { return rooms_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoomListMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoomListMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!rooms_.Equals(other.rooms_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= rooms_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      rooms_.WriteTo(output, _repeated_rooms_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      rooms_.WriteTo(ref output, _repeated_rooms_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += rooms_.CalculateSize(_repeated_rooms_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoomListMessage other) {
+      if (other == null) {
+        return;
+      }
+      rooms_.Add(other.rooms_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            rooms_.AddEntriesFrom(input, _repeated_rooms_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            rooms_.AddEntriesFrom(ref input, _repeated_rooms_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Pick the room to play in after logging in, 0 leaves it up to matchmaking
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class JoinRoomRequestMessage : pb::IMessage<JoinRoomRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<JoinRoomRequestMessage> _parser = new pb::MessageParser<JoinRoomRequestMessage>(() => new JoinRoomRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<JoinRoomRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[35]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public JoinRoomRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public JoinRoomRequestMessage(JoinRoomRequestMessage other) : this() {
+      roomId_ = other.roomId_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public JoinRoomRequestMessage Clone() {
+      return new JoinRoomRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "room_id" field.</summary>
+    public const int RoomIdFieldNumber = 1;
+    private ulong roomId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong RoomId {
+      get { return roomId_; }
+      set {
+        roomId_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as JoinRoomRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(JoinRoomRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (RoomId != other.RoomId) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (RoomId != 0UL) hash ^= RoomId.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (RoomId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(RoomId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (RoomId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(RoomId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (RoomId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(RoomId);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(JoinRoomRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.RoomId != 0UL) {
+        RoomId = other.RoomId;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            RoomId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            RoomId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class CreateRoomRequestMessage : pb::IMessage<CreateRoomRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<CreateRoomRequestMessage> _parser = new pb::MessageParser<CreateRoomRequestMessage>(() => new CreateRoomRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<CreateRoomRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[36]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CreateRoomRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CreateRoomRequestMessage(CreateRoomRequestMessage other) : this() {
+      name_ = other.name_;
+      capacity_ = other.capacity_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CreateRoomRequestMessage Clone() {
+      return new CreateRoomRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 1;
+    private string name_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Name {
+      get { return name_; }
+      set {
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "capacity" field.</summary>
+    public const int CapacityFieldNumber = 2;
+    private uint capacity_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Capacity {
+      get { return capacity_; }
+      set {
+        capacity_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as CreateRoomRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(CreateRoomRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Name != other.Name) return false;
+      if (Capacity != other.Capacity) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Name.Length != 0) hash ^= Name.GetHashCode();
+      if (Capacity != 0) hash ^= Capacity.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Name.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Name);
+      }
+      if (Capacity != 0) {
+        output.WriteRawTag(16);
+        output.WriteUInt32(Capacity);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Name.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Name);
+      }
+      if (Capacity != 0) {
+        output.WriteRawTag(16);
+        output.WriteUInt32(Capacity);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Name.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
+      }
+      if (Capacity != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Capacity);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(CreateRoomRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Name.Length != 0) {
+        Name = other.Name;
+      }
+      if (other.Capacity != 0) {
+        Capacity = other.Capacity;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Name = input.ReadString();
+            break;
+          }
+          case 16: {
+            Capacity = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Name = input.ReadString();
+            break;
+          }
+          case 16: {
+            Capacity = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoomCreatedMessage : pb::IMessage<RoomCreatedMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoomCreatedMessage> _parser = new pb::MessageParser<RoomCreatedMessage>(() => new RoomCreatedMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoomCreatedMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[37]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomCreatedMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomCreatedMessage(RoomCreatedMessage other) : this() {
+      roomId_ = other.roomId_;
+      inviteCode_ = other.inviteCode_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoomCreatedMessage Clone() {
+      return new RoomCreatedMessage(this);
+    }
+
+    /// <summary>Field number for the "room_id" field.</summary>
+    public const int RoomIdFieldNumber = 1;
+    private ulong roomId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong RoomId {
+      get { return roomId_; }
+      set {
+        roomId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "invite_code" field.</summary>
+    public const int InviteCodeFieldNumber = 2;
+    private string inviteCode_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string InviteCode {
+      get { return inviteCode_; }
+      set {
+        inviteCode_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoomCreatedMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoomCreatedMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (RoomId != other.RoomId) return false;
+      if (InviteCode != other.InviteCode) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (RoomId != 0UL) hash ^= RoomId.GetHashCode();
+      if (InviteCode.Length != 0) hash ^= InviteCode.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (RoomId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(RoomId);
+      }
+      if (InviteCode.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(InviteCode);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (RoomId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(RoomId);
+      }
+      if (InviteCode.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(InviteCode);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (RoomId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(RoomId);
+      }
+      if (InviteCode.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(InviteCode);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoomCreatedMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.RoomId != 0UL) {
+        RoomId = other.RoomId;
+      }
+      if (other.InviteCode.Length != 0) {
+        InviteCode = other.InviteCode;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            RoomId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            InviteCode = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            RoomId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            InviteCode = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class JoinRoomByCodeRequestMessage : pb::IMessage<JoinRoomByCodeRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<JoinRoomByCodeRequestMessage> _parser = new pb::MessageParser<JoinRoomByCodeRequestMessage>(() => new JoinRoomByCodeRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<JoinRoomByCodeRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[38]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public JoinRoomByCodeRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public JoinRoomByCodeRequestMessage(JoinRoomByCodeRequestMessage other) : this() {
+      inviteCode_ = other.inviteCode_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public JoinRoomByCodeRequestMessage Clone() {
+      return new JoinRoomByCodeRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "invite_code" field.</summary>
+    public const int InviteCodeFieldNumber = 1;
+    private string inviteCode_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string InviteCode {
+      get { return inviteCode_; }
+      set {
+        inviteCode_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as JoinRoomByCodeRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(JoinRoomByCodeRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (InviteCode != other.InviteCode) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (InviteCode.Length != 0) hash ^= InviteCode.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (InviteCode.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(InviteCode);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (InviteCode.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(InviteCode);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (InviteCode.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(InviteCode);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(JoinRoomByCodeRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.InviteCode.Length != 0) {
+        InviteCode = other.InviteCode;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            InviteCode = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            InviteCode = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class KickPlayerRequestMessage : pb::IMessage<KickPlayerRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<KickPlayerRequestMessage> _parser = new pb::MessageParser<KickPlayerRequestMessage>(() => new KickPlayerRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<KickPlayerRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[39]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public KickPlayerRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public KickPlayerRequestMessage(KickPlayerRequestMessage other) : this() {
+      playerId_ = other.playerId_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public KickPlayerRequestMessage Clone() {
+      return new KickPlayerRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as KickPlayerRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(KickPlayerRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(KickPlayerRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class LockRoomRequestMessage : pb::IMessage<LockRoomRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<LockRoomRequestMessage> _parser = new pb::MessageParser<LockRoomRequestMessage>(() => new LockRoomRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<LockRoomRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[40]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public LockRoomRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public LockRoomRequestMessage(LockRoomRequestMessage other) : this() {
+      locked_ = other.locked_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public LockRoomRequestMessage Clone() {
+      return new LockRoomRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "locked" field.</summary>
+    public const int LockedFieldNumber = 1;
+    private bool locked_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Locked {
+      get { return locked_; }
+      set {
+        locked_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as LockRoomRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(LockRoomRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Locked != other.Locked) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Locked != false) hash ^= Locked.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Locked != false) {
+        output.WriteRawTag(8);
+        output.WriteBool(Locked);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Locked != false) {
+        output.WriteRawTag(8);
+        output.WriteBool(Locked);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Locked != false) {
+        size += 1 + 1;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(LockRoomRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Locked != false) {
+        Locked = other.Locked;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Locked = input.ReadBool();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Locked = input.ReadBool();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class CloseRoomRequestMessage : pb::IMessage<CloseRoomRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<CloseRoomRequestMessage> _parser = new pb::MessageParser<CloseRoomRequestMessage>(() => new CloseRoomRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<CloseRoomRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[41]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CloseRoomRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CloseRoomRequestMessage(CloseRoomRequestMessage other) : this() {
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CloseRoomRequestMessage Clone() {
+      return new CloseRoomRequestMessage(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as CloseRoomRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(CloseRoomRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(CloseRoomRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RemovedFromRoomMessage : pb::IMessage<RemovedFromRoomMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RemovedFromRoomMessage> _parser = new pb::MessageParser<RemovedFromRoomMessage>(() => new RemovedFromRoomMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RemovedFromRoomMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[42]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RemovedFromRoomMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RemovedFromRoomMessage(RemovedFromRoomMessage other) : this() {
+      reason_ = other.reason_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RemovedFromRoomMessage Clone() {
+      return new RemovedFromRoomMessage(this);
+    }
+
+    /// <summary>Field number for the "reason" field.</summary>
+    public const int ReasonFieldNumber = 1;
+    private string reason_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Reason {
+      get { return reason_; }
+      set {
+        reason_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RemovedFromRoomMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RemovedFromRoomMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Reason != other.Reason) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Reason.Length != 0) hash ^= Reason.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Reason.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Reason);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Reason.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Reason);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Reason.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Reason);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RemovedFromRoomMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Reason.Length != 0) {
+        Reason = other.Reason;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Reason = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Reason = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class GameOverMessage : pb::IMessage<GameOverMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<GameOverMessage> _parser = new pb::MessageParser<GameOverMessage>(() => new GameOverMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<GameOverMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[43]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameOverMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameOverMessage(GameOverMessage other) : this() {
+      winnerId_ = other.winnerId_;
+      winnerName_ = other.winnerName_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameOverMessage Clone() {
+      return new GameOverMessage(this);
+    }
+
+    /// <summary>Field number for the "winner_id" field.</summary>
+    public const int WinnerIdFieldNumber = 1;
+    private ulong winnerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong WinnerId {
+      get { return winnerId_; }
+      set {
+        winnerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "winner_name" field.</summary>
+    public const int WinnerNameFieldNumber = 2;
+    private string winnerName_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string WinnerName {
+      get { return winnerName_; }
+      set {
+        winnerName_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as GameOverMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(GameOverMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (WinnerId != other.WinnerId) return false;
+      if (WinnerName != other.WinnerName) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (WinnerId != 0UL) hash ^= WinnerId.GetHashCode();
+      if (WinnerName.Length != 0) hash ^= WinnerName.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (WinnerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(WinnerId);
+      }
+      if (WinnerName.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(WinnerName);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (WinnerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(WinnerId);
+      }
+      if (WinnerName.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(WinnerName);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (WinnerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(WinnerId);
+      }
+      if (WinnerName.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(WinnerName);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(GameOverMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.WinnerId != 0UL) {
+        WinnerId = other.WinnerId;
+      }
+      if (other.WinnerName.Length != 0) {
+        WinnerName = other.WinnerName;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            WinnerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            WinnerName = input.ReadString();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            WinnerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            WinnerName = input.ReadString();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Team 0 means the player isn't on a team
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class TeamAssignmentMessage : pb::IMessage<TeamAssignmentMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<TeamAssignmentMessage> _parser = new pb::MessageParser<TeamAssignmentMessage>(() => new TeamAssignmentMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<TeamAssignmentMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[44]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamAssignmentMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamAssignmentMessage(TeamAssignmentMessage other) : this() {
+      playerId_ = other.playerId_;
+      team_ = other.team_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamAssignmentMessage Clone() {
+      return new TeamAssignmentMessage(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "team" field.</summary>
+    public const int TeamFieldNumber = 2;
+    private uint team_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Team {
+      get { return team_; }
+      set {
+        team_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as TeamAssignmentMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(TeamAssignmentMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      if (Team != other.Team) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (Team != 0) hash ^= Team.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Team != 0) {
+        output.WriteRawTag(16);
+        output.WriteUInt32(Team);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Team != 0) {
+        output.WriteRawTag(16);
+        output.WriteUInt32(Team);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (Team != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Team);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(TeamAssignmentMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      if (other.Team != 0) {
+        Team = other.Team;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            Team = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 16: {
+            Team = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class TeamScoreMessage : pb::IMessage<TeamScoreMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<TeamScoreMessage> _parser = new pb::MessageParser<TeamScoreMessage>(() => new TeamScoreMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<TeamScoreMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[45]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamScoreMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamScoreMessage(TeamScoreMessage other) : this() {
+      team_ = other.team_;
+      name_ = other.name_;
+      color_ = other.color_;
+      mass_ = other.mass_;
+      players_ = other.players_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamScoreMessage Clone() {
+      return new TeamScoreMessage(this);
+    }
+
+    /// <summary>Field number for the "team" field.</summary>
+    public const int TeamFieldNumber = 1;
+    private uint team_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Team {
+      get { return team_; }
+      set {
+        team_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 2;
+    private string name_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Name {
+      get { return name_; }
+      set {
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "color" field.</summary>
+    public const int ColorFieldNumber = 3;
+    private uint color_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Color {
+      get { return color_; }
+      set {
+        color_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "mass" field.</summary>
+    public const int MassFieldNumber = 4;
+    private ulong mass_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Mass {
+      get { return mass_; }
+      set {
+        mass_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "players" field.</summary>
+    public const int PlayersFieldNumber = 5;
+    private uint players_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Players {
+      get { return players_; }
+      set {
+        players_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as TeamScoreMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(TeamScoreMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Team != other.Team) return false;
+      if (Name != other.Name) return false;
+      if (Color != other.Color) return false;
+      if (Mass != other.Mass) return false;
+      if (Players != other.Players) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Team != 0) hash ^= Team.GetHashCode();
+      if (Name.Length != 0) hash ^= Name.GetHashCode();
+      if (Color != 0) hash ^= Color.GetHashCode();
+      if (Mass != 0UL) hash ^= Mass.GetHashCode();
+      if (Players != 0) hash ^= Players.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Team != 0) {
+        output.WriteRawTag(8);
+        output.WriteUInt32(Team);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Color != 0) {
+        output.WriteRawTag(24);
+        output.WriteUInt32(Color);
+      }
+      if (Mass != 0UL) {
+        output.WriteRawTag(32);
+        output.WriteUInt64(Mass);
+      }
+      if (Players != 0) {
+        output.WriteRawTag(40);
+        output.WriteUInt32(Players);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Team != 0) {
+        output.WriteRawTag(8);
+        output.WriteUInt32(Team);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Color != 0) {
+        output.WriteRawTag(24);
+        output.WriteUInt32(Color);
+      }
+      if (Mass != 0UL) {
+        output.WriteRawTag(32);
+        output.WriteUInt64(Mass);
+      }
+      if (Players != 0) {
+        output.WriteRawTag(40);
+        output.WriteUInt32(Players);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Team != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Team);
+      }
+      if (Name.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
+      }
+      if (Color != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Color);
+      }
+      if (Mass != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Mass);
+      }
+      if (Players != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Players);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(TeamScoreMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Team != 0) {
+        Team = other.Team;
+      }
+      if (other.Name.Length != 0) {
+        Name = other.Name;
+      }
+      if (other.Color != 0) {
+        Color = other.Color;
+      }
+      if (other.Mass != 0UL) {
+        Mass = other.Mass;
+      }
+      if (other.Players != 0) {
+        Players = other.Players;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Team = input.ReadUInt32();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Color = input.ReadUInt32();
+            break;
+          }
+          case 32: {
+            Mass = input.ReadUInt64();
+            break;
+          }
+          case 40: {
+            Players = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Team = input.ReadUInt32();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Color = input.ReadUInt32();
+            break;
+          }
+          case 32: {
+            Mass = input.ReadUInt64();
+            break;
+          }
+          case 40: {
+            Players = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class TeamScoreboardMessage : pb::IMessage<TeamScoreboardMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<TeamScoreboardMessage> _parser = new pb::MessageParser<TeamScoreboardMessage>(() => new TeamScoreboardMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<TeamScoreboardMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[46]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamScoreboardMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamScoreboardMessage(TeamScoreboardMessage other) : this() {
+      teams_ = other.teams_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public TeamScoreboardMessage Clone() {
+      return new TeamScoreboardMessage(this);
+    }
+
+    /// <summary>Field number for the "teams" field.</summary>
+    public const int TeamsFieldNumber = 1;
+    private static readonly pb::FieldCodec<global::Client.Packets.TeamScoreMessage> _repeated_teams_codec
+        = pb::FieldCodec.ForMessage(10, global::Client.Packets.TeamScoreMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.TeamScoreMessage> teams_ = new pbc::RepeatedField<global::Client.Packets.TeamScoreMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.TeamScoreMessage> Teams {
+      get { return teams_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as TeamScoreboardMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(TeamScoreboardMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!teams_.Equals(other.teams_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= teams_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      teams_.WriteTo(output, _repeated_teams_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      teams_.WriteTo(ref output, _repeated_teams_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += teams_.CalculateSize(_repeated_teams_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(TeamScoreboardMessage other) {
+      if (other == null) {
+        return;
+      }
+      teams_.Add(other.teams_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            teams_.AddEntriesFrom(input, _repeated_teams_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            teams_.AddEntriesFrom(ref input, _repeated_teams_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoundCountdownMessage : pb::IMessage<RoundCountdownMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoundCountdownMessage> _parser = new pb::MessageParser<RoundCountdownMessage>(() => new RoundCountdownMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoundCountdownMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[47]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundCountdownMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundCountdownMessage(RoundCountdownMessage other) : this() {
+      secondsLeft_ = other.secondsLeft_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundCountdownMessage Clone() {
+      return new RoundCountdownMessage(this);
+    }
+
+    /// <summary>Field number for the "seconds_left" field.</summary>
+    public const int SecondsLeftFieldNumber = 1;
+    private uint secondsLeft_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint SecondsLeft {
+      get { return secondsLeft_; }
+      set {
+        secondsLeft_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoundCountdownMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoundCountdownMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (SecondsLeft != other.SecondsLeft) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (SecondsLeft != 0) hash ^= SecondsLeft.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (SecondsLeft != 0) {
+        output.WriteRawTag(8);
+        output.WriteUInt32(SecondsLeft);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (SecondsLeft != 0) {
+        output.WriteRawTag(8);
+        output.WriteUInt32(SecondsLeft);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (SecondsLeft != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(SecondsLeft);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoundCountdownMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.SecondsLeft != 0) {
+        SecondsLeft = other.SecondsLeft;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            SecondsLeft = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            SecondsLeft = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoundResultMessage : pb::IMessage<RoundResultMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoundResultMessage> _parser = new pb::MessageParser<RoundResultMessage>(() => new RoundResultMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoundResultMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[48]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundResultMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundResultMessage(RoundResultMessage other) : this() {
+      playerId_ = other.playerId_;
+      name_ = other.name_;
+      mass_ = other.mass_;
+      rank_ = other.rank_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundResultMessage Clone() {
+      return new RoundResultMessage(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 2;
+    private string name_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Name {
+      get { return name_; }
+      set {
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "mass" field.</summary>
+    public const int MassFieldNumber = 3;
+    private ulong mass_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong Mass {
+      get { return mass_; }
+      set {
+        mass_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "rank" field.</summary>
+    public const int RankFieldNumber = 4;
+    private uint rank_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Rank {
+      get { return rank_; }
+      set {
+        rank_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoundResultMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoundResultMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      if (Name != other.Name) return false;
+      if (Mass != other.Mass) return false;
+      if (Rank != other.Rank) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (Name.Length != 0) hash ^= Name.GetHashCode();
+      if (Mass != 0UL) hash ^= Mass.GetHashCode();
+      if (Rank != 0) hash ^= Rank.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Mass != 0UL) {
+        output.WriteRawTag(24);
+        output.WriteUInt64(Mass);
+      }
+      if (Rank != 0) {
+        output.WriteRawTag(32);
+        output.WriteUInt32(Rank);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Mass != 0UL) {
+        output.WriteRawTag(24);
+        output.WriteUInt64(Mass);
+      }
+      if (Rank != 0) {
+        output.WriteRawTag(32);
+        output.WriteUInt32(Rank);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (Name.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
+      }
+      if (Mass != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(Mass);
+      }
+      if (Rank != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Rank);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoundResultMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      if (other.Name.Length != 0) {
+        Name = other.Name;
+      }
+      if (other.Mass != 0UL) {
+        Mass = other.Mass;
+      }
+      if (other.Rank != 0) {
+        Rank = other.Rank;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Mass = input.ReadUInt64();
+            break;
+          }
+          case 32: {
+            Rank = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Mass = input.ReadUInt64();
+            break;
+          }
+          case 32: {
+            Rank = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// The final standings of a round, best first
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RoundEndMessage : pb::IMessage<RoundEndMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RoundEndMessage> _parser = new pb::MessageParser<RoundEndMessage>(() => new RoundEndMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RoundEndMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[49]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundEndMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundEndMessage(RoundEndMessage other) : this() {
+      results_ = other.results_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RoundEndMessage Clone() {
+      return new RoundEndMessage(this);
+    }
+
+    /// <summary>Field number for the "results" field.</summary>
+    public const int ResultsFieldNumber = 1;
+    private static readonly pb::FieldCodec<global::Client.Packets.RoundResultMessage> _repeated_results_codec
+        = pb::FieldCodec.ForMessage(10, global::Client.Packets.RoundResultMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.RoundResultMessage> results_ = new pbc::RepeatedField<global::Client.Packets.RoundResultMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.RoundResultMessage> Results {
+      get { return results_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RoundEndMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RoundEndMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if(!results_.Equals(other.results_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      hash ^= results_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      results_.WriteTo(output, _repeated_results_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      results_.WriteTo(ref output, _repeated_results_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      size += results_.CalculateSize(_repeated_results_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RoundEndMessage other) {
+      if (other == null) {
+        return;
+      }
+      results_.Add(other.results_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            results_.AddEntriesFrom(input, _repeated_results_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            results_.AddEntriesFrom(ref input, _repeated_results_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// The rectangle players can move in, sent when entering a room
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class WorldBoundsMessage : pb::IMessage<WorldBoundsMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<WorldBoundsMessage> _parser = new pb::MessageParser<WorldBoundsMessage>(() => new WorldBoundsMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<WorldBoundsMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[50]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public WorldBoundsMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public WorldBoundsMessage(WorldBoundsMessage other) : this() {
+      minX_ = other.minX_;
+      minY_ = other.minY_;
+      maxX_ = other.maxX_;
+      maxY_ = other.maxY_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public WorldBoundsMessage Clone() {
+      return new WorldBoundsMessage(this);
+    }
+
+    /// <summary>Field number for the "min_x" field.</summary>
+    public const int MinXFieldNumber = 1;
+    private double minX_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double MinX {
+      get { return minX_; }
+      set {
+        minX_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "min_y" field.</summary>
+    public const int MinYFieldNumber = 2;
+    private double minY_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double MinY {
+      get { return minY_; }
+      set {
+        minY_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "max_x" field.</summary>
+    public const int MaxXFieldNumber = 3;
+    private double maxX_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double MaxX {
+      get { return maxX_; }
+      set {
+        maxX_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "max_y" field.</summary>
+    public const int MaxYFieldNumber = 4;
+    private double maxY_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double MaxY {
+      get { return maxY_; }
+      set {
+        maxY_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as WorldBoundsMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(WorldBoundsMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(MinX, other.MinX)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(MinY, other.MinY)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(MaxX, other.MaxX)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(MaxY, other.MaxY)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (MinX != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(MinX);
+      if (MinY != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(MinY);
+      if (MaxX != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(MaxX);
+      if (MaxY != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(MaxY);
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (MinX != 0D) {
+        output.WriteRawTag(9);
+        output.WriteDouble(MinX);
+      }
+      if (MinY != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(MinY);
+      }
+      if (MaxX != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(MaxX);
+      }
+      if (MaxY != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(MaxY);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (MinX != 0D) {
+        output.WriteRawTag(9);
+        output.WriteDouble(MinX);
+      }
+      if (MinY != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(MinY);
+      }
+      if (MaxX != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(MaxX);
+      }
+      if (MaxY != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(MaxY);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (MinX != 0D) {
+        size += 1 + 8;
+      }
+      if (MinY != 0D) {
+        size += 1 + 8;
+      }
+      if (MaxX != 0D) {
+        size += 1 + 8;
+      }
+      if (MaxY != 0D) {
+        size += 1 + 8;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(WorldBoundsMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.MinX != 0D) {
+        MinX = other.MinX;
+      }
+      if (other.MinY != 0D) {
+        MinY = other.MinY;
+      }
+      if (other.MaxX != 0D) {
+        MaxX = other.MaxX;
+      }
+      if (other.MaxY != 0D) {
+        MaxY = other.MaxY;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 9: {
+            MinX = input.ReadDouble();
+            break;
+          }
+          case 17: {
+            MinY = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            MaxX = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            MaxY = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 9: {
+            MinX = input.ReadDouble();
+            break;
+          }
+          case 17: {
+            MinY = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            MaxX = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            MaxY = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Players outside the safe zone lose mass, it shrinks towards target_radius while a battle royale game is running
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class ZoneMessage : pb::IMessage<ZoneMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<ZoneMessage> _parser = new pb::MessageParser<ZoneMessage>(() => new ZoneMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<ZoneMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[51]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ZoneMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ZoneMessage(ZoneMessage other) : this() {
+      x_ = other.x_;
+      y_ = other.y_;
+      radius_ = other.radius_;
+      targetRadius_ = other.targetRadius_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ZoneMessage Clone() {
+      return new ZoneMessage(this);
+    }
+
+    /// <summary>Field number for the "x" field.</summary>
+    public const int XFieldNumber = 1;
+    private double x_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double X {
+      get { return x_; }
+      set {
+        x_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "y" field.</summary>
+    public const int YFieldNumber = 2;
+    private double y_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Y {
+      get { return y_; }
+      set {
+        y_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "radius" field.</summary>
+    public const int RadiusFieldNumber = 3;
+    private double radius_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double Radius {
+      get { return radius_; }
+      set {
+        radius_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "target_radius" field.</summary>
+    public const int TargetRadiusFieldNumber = 4;
+    private double targetRadius_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double TargetRadius {
+      get { return targetRadius_; }
+      set {
+        targetRadius_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as ZoneMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(ZoneMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(X, other.X)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Y, other.Y)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(Radius, other.Radius)) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(TargetRadius, other.TargetRadius)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (X != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(X);
+      if (Y != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Y);
+      if (Radius != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(Radius);
+      if (TargetRadius != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(TargetRadius);
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (X != 0D) {
+        output.WriteRawTag(9);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Radius);
+      }
+      if (TargetRadius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(TargetRadius);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (X != 0D) {
+        output.WriteRawTag(9);
+        output.WriteDouble(X);
+      }
+      if (Y != 0D) {
+        output.WriteRawTag(17);
+        output.WriteDouble(Y);
+      }
+      if (Radius != 0D) {
+        output.WriteRawTag(25);
+        output.WriteDouble(Radius);
+      }
+      if (TargetRadius != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(TargetRadius);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (X != 0D) {
+        size += 1 + 8;
+      }
+      if (Y != 0D) {
+        size += 1 + 8;
+      }
+      if (Radius != 0D) {
+        size += 1 + 8;
+      }
+      if (TargetRadius != 0D) {
+        size += 1 + 8;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(ZoneMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.X != 0D) {
+        X = other.X;
+      }
+      if (other.Y != 0D) {
+        Y = other.Y;
+      }
+      if (other.Radius != 0D) {
+        Radius = other.Radius;
+      }
+      if (other.TargetRadius != 0D) {
+        TargetRadius = other.TargetRadius;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 9: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 17: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            TargetRadius = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 9: {
+            X = input.ReadDouble();
+            break;
+          }
+          case 17: {
+            Y = input.ReadDouble();
+            break;
+          }
+          case 25: {
+            Radius = input.ReadDouble();
+            break;
+          }
+          case 33: {
+            TargetRadius = input.ReadDouble();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class GameMapMessage : pb::IMessage<GameMapMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<GameMapMessage> _parser = new pb::MessageParser<GameMapMessage>(() => new GameMapMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<GameMapMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[52]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameMapMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameMapMessage(GameMapMessage other) : this() {
+      name_ = other.name_;
+      obstacles_ = other.obstacles_.Clone();
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public GameMapMessage Clone() {
+      return new GameMapMessage(this);
+    }
+
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 1;
+    private string name_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Name {
+      get { return name_; }
+      set {
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "obstacles" field.</summary>
+    public const int ObstaclesFieldNumber = 2;
+    private static readonly pb::FieldCodec<global::Client.Packets.WorldBoundsMessage> _repeated_obstacles_codec
+        = pb::FieldCodec.ForMessage(18, global::Client.Packets.WorldBoundsMessage.Parser);
+    private readonly pbc::RepeatedField<global::Client.Packets.WorldBoundsMessage> obstacles_ = new pbc::RepeatedField<global::Client.Packets.WorldBoundsMessage>();
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public pbc::RepeatedField<global::Client.Packets.WorldBoundsMessage> Obstacles {
+      get { return obstacles_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as GameMapMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(GameMapMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Name != other.Name) return false;
+      if(!obstacles_.Equals(other.obstacles_)) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Name.Length != 0) hash ^= Name.GetHashCode();
+      hash ^= obstacles_.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Name.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Name);
+      }
+      obstacles_.WriteTo(output, _repeated_obstacles_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Name.Length != 0) {
+        output.WriteRawTag(10);
+        output.WriteString(Name);
+      }
+      obstacles_.WriteTo(ref output, _repeated_obstacles_codec);
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Name.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
+      }
+      size += obstacles_.CalculateSize(_repeated_obstacles_codec);
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(GameMapMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Name.Length != 0) {
+        Name = other.Name;
+      }
+      obstacles_.Add(other.obstacles_);
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 10: {
+            Name = input.ReadString();
+            break;
+          }
+          case 18: {
+            obstacles_.AddEntriesFrom(input, _repeated_obstacles_codec);
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 10: {
+            Name = input.ReadString();
+            break;
+          }
+          case 18: {
+            obstacles_.AddEntriesFrom(ref input, _repeated_obstacles_codec);
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// How the life that just ended went, killer_id is 0 if nobody ate the player
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class DeathSummaryMessage : pb::IMessage<DeathSummaryMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<DeathSummaryMessage> _parser = new pb::MessageParser<DeathSummaryMessage>(() => new DeathSummaryMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<DeathSummaryMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[53]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DeathSummaryMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DeathSummaryMessage(DeathSummaryMessage other) : this() {
+      killerId_ = other.killerId_;
+      killerName_ = other.killerName_;
+      timeAliveMs_ = other.timeAliveMs_;
+      peakMass_ = other.peakMass_;
+      kills_ = other.kills_;
+      sporesEaten_ = other.sporesEaten_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public DeathSummaryMessage Clone() {
+      return new DeathSummaryMessage(this);
+    }
+
+    /// <summary>Field number for the "killer_id" field.</summary>
+    public const int KillerIdFieldNumber = 1;
+    private ulong killerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong KillerId {
+      get { return killerId_; }
+      set {
+        killerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "killer_name" field.</summary>
+    public const int KillerNameFieldNumber = 2;
+    private string killerName_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string KillerName {
+      get { return killerName_; }
+      set {
+        killerName_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "time_alive_ms" field.</summary>
+    public const int TimeAliveMsFieldNumber = 3;
+    private ulong timeAliveMs_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong TimeAliveMs {
+      get { return timeAliveMs_; }
+      set {
+        timeAliveMs_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "peak_mass" field.</summary>
+    public const int PeakMassFieldNumber = 4;
+    private double peakMass_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public double PeakMass {
+      get { return peakMass_; }
+      set {
+        peakMass_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "kills" field.</summary>
+    public const int KillsFieldNumber = 5;
+    private uint kills_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint Kills {
+      get { return kills_; }
+      set {
+        kills_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "spores_eaten" field.</summary>
+    public const int SporesEatenFieldNumber = 6;
+    private uint sporesEaten_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public uint SporesEaten {
+      get { return sporesEaten_; }
+      set {
+        sporesEaten_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as DeathSummaryMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(DeathSummaryMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (KillerId != other.KillerId) return false;
+      if (KillerName != other.KillerName) return false;
+      if (TimeAliveMs != other.TimeAliveMs) return false;
+      if (!pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.Equals(PeakMass, other.PeakMass)) return false;
+      if (Kills != other.Kills) return false;
+      if (SporesEaten != other.SporesEaten) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (KillerId != 0UL) hash ^= KillerId.GetHashCode();
+      if (KillerName.Length != 0) hash ^= KillerName.GetHashCode();
+      if (TimeAliveMs != 0UL) hash ^= TimeAliveMs.GetHashCode();
+      if (PeakMass != 0D) hash ^= pbc::ProtobufEqualityComparers.BitwiseDoubleEqualityComparer.GetHashCode(PeakMass);
+      if (Kills != 0) hash ^= Kills.GetHashCode();
+      if (SporesEaten != 0) hash ^= SporesEaten.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (KillerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(KillerId);
+      }
+      if (KillerName.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(KillerName);
+      }
+      if (TimeAliveMs != 0UL) {
+        output.WriteRawTag(24);
+        output.WriteUInt64(TimeAliveMs);
+      }
+      if (PeakMass != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(PeakMass);
+      }
+      if (Kills != 0) {
+        output.WriteRawTag(40);
+        output.WriteUInt32(Kills);
+      }
+      if (SporesEaten != 0) {
+        output.WriteRawTag(48);
+        output.WriteUInt32(SporesEaten);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (KillerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(KillerId);
+      }
+      if (KillerName.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(KillerName);
+      }
+      if (TimeAliveMs != 0UL) {
+        output.WriteRawTag(24);
+        output.WriteUInt64(TimeAliveMs);
+      }
+      if (PeakMass != 0D) {
+        output.WriteRawTag(33);
+        output.WriteDouble(PeakMass);
+      }
+      if (Kills != 0) {
+        output.WriteRawTag(40);
+        output.WriteUInt32(Kills);
+      }
+      if (SporesEaten != 0) {
+        output.WriteRawTag(48);
+        output.WriteUInt32(SporesEaten);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (KillerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(KillerId);
+      }
+      if (KillerName.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(KillerName);
+      }
+      if (TimeAliveMs != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(TimeAliveMs);
+      }
+      if (PeakMass != 0D) {
+        size += 1 + 8;
+      }
+      if (Kills != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(Kills);
+      }
+      if (SporesEaten != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt32Size(SporesEaten);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(DeathSummaryMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.KillerId != 0UL) {
+        KillerId = other.KillerId;
+      }
+      if (other.KillerName.Length != 0) {
+        KillerName = other.KillerName;
+      }
+      if (other.TimeAliveMs != 0UL) {
+        TimeAliveMs = other.TimeAliveMs;
+      }
+      if (other.PeakMass != 0D) {
+        PeakMass = other.PeakMass;
+      }
+      if (other.Kills != 0) {
+        Kills = other.Kills;
+      }
+      if (other.SporesEaten != 0) {
+        SporesEaten = other.SporesEaten;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            KillerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            KillerName = input.ReadString();
+            break;
+          }
+          case 24: {
+            TimeAliveMs = input.ReadUInt64();
+            break;
+          }
+          case 33: {
+            PeakMass = input.ReadDouble();
+            break;
+          }
+          case 40: {
+            Kills = input.ReadUInt32();
+            break;
+          }
+          case 48: {
+            SporesEaten = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            KillerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            KillerName = input.ReadString();
+            break;
+          }
+          case 24: {
+            TimeAliveMs = input.ReadUInt64();
+            break;
+          }
+          case 33: {
+            PeakMass = input.ReadDouble();
+            break;
+          }
+          case 40: {
+            Kills = input.ReadUInt32();
+            break;
+          }
+          case 48: {
+            SporesEaten = input.ReadUInt32();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class RespawnRequestMessage : pb::IMessage<RespawnRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<RespawnRequestMessage> _parser = new pb::MessageParser<RespawnRequestMessage>(() => new RespawnRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<RespawnRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[54]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RespawnRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RespawnRequestMessage(RespawnRequestMessage other) : this() {
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public RespawnRequestMessage Clone() {
+      return new RespawnRequestMessage(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as RespawnRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(RespawnRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(RespawnRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class SpectateRoomRequestMessage : pb::IMessage<SpectateRoomRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<SpectateRoomRequestMessage> _parser = new pb::MessageParser<SpectateRoomRequestMessage>(() => new SpectateRoomRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<SpectateRoomRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[55]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SpectateRoomRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SpectateRoomRequestMessage(SpectateRoomRequestMessage other) : this() {
+      roomId_ = other.roomId_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SpectateRoomRequestMessage Clone() {
+      return new SpectateRoomRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "room_id" field.</summary>
+    public const int RoomIdFieldNumber = 1;
+    private ulong roomId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong RoomId {
+      get { return roomId_; }
+      set {
+        roomId_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as SpectateRoomRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(SpectateRoomRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (RoomId != other.RoomId) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (RoomId != 0UL) hash ^= RoomId.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (RoomId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(RoomId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (RoomId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(RoomId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (RoomId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(RoomId);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(SpectateRoomRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.RoomId != 0UL) {
+        RoomId = other.RoomId;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            RoomId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            RoomId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Watch the player with the given ID, or whoever is in the lead if it's 0
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class FollowPlayerRequestMessage : pb::IMessage<FollowPlayerRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<FollowPlayerRequestMessage> _parser = new pb::MessageParser<FollowPlayerRequestMessage>(() => new FollowPlayerRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<FollowPlayerRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[56]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public FollowPlayerRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public FollowPlayerRequestMessage(FollowPlayerRequestMessage other) : this() {
+      playerId_ = other.playerId_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public FollowPlayerRequestMessage Clone() {
+      return new FollowPlayerRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as FollowPlayerRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(FollowPlayerRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(FollowPlayerRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Switch to watching the next player, or the previous one
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class CycleSpectateTargetRequestMessage : pb::IMessage<CycleSpectateTargetRequestMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<CycleSpectateTargetRequestMessage> _parser = new pb::MessageParser<CycleSpectateTargetRequestMessage>(() => new CycleSpectateTargetRequestMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<CycleSpectateTargetRequestMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[57]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CycleSpectateTargetRequestMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CycleSpectateTargetRequestMessage(CycleSpectateTargetRequestMessage other) : this() {
+      previous_ = other.previous_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public CycleSpectateTargetRequestMessage Clone() {
+      return new CycleSpectateTargetRequestMessage(this);
+    }
+
+    /// <summary>Field number for the "previous" field.</summary>
+    public const int PreviousFieldNumber = 1;
+    private bool previous_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Previous {
+      get { return previous_; }
+      set {
+        previous_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as CycleSpectateTargetRequestMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(CycleSpectateTargetRequestMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (Previous != other.Previous) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (Previous != false) hash ^= Previous.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (Previous != false) {
+        output.WriteRawTag(8);
+        output.WriteBool(Previous);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (Previous != false) {
+        output.WriteRawTag(8);
+        output.WriteBool(Previous);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (Previous != false) {
+        size += 1 + 1;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(CycleSpectateTargetRequestMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.Previous != false) {
+        Previous = other.Previous;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            Previous = input.ReadBool();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            Previous = input.ReadBool();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  /// <summary>
+  /// Who a spectator is watching now, leader is set if they follow whoever is in the lead
+  /// </summary>
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class SpectateTargetMessage : pb::IMessage<SpectateTargetMessage>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<SpectateTargetMessage> _parser = new pb::MessageParser<SpectateTargetMessage>(() => new SpectateTargetMessage());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<SpectateTargetMessage> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[58]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SpectateTargetMessage() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SpectateTargetMessage(SpectateTargetMessage other) : this() {
+      playerId_ = other.playerId_;
+      name_ = other.name_;
+      leader_ = other.leader_;
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public SpectateTargetMessage Clone() {
+      return new SpectateTargetMessage(this);
+    }
+
+    /// <summary>Field number for the "player_id" field.</summary>
+    public const int PlayerIdFieldNumber = 1;
+    private ulong playerId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong PlayerId {
+      get { return playerId_; }
+      set {
+        playerId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "name" field.</summary>
+    public const int NameFieldNumber = 2;
+    private string name_ = "";
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public string Name {
+      get { return name_; }
+      set {
+        name_ = pb::ProtoPreconditions.CheckNotNull(value, "value");
+      }
+    }
+
+    /// <summary>Field number for the "leader" field.</summary>
+    public const int LeaderFieldNumber = 3;
+    private bool leader_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Leader {
+      get { return leader_; }
+      set {
+        leader_ = value;
+      }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as SpectateTargetMessage);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(SpectateTargetMessage other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (PlayerId != other.PlayerId) return false;
+      if (Name != other.Name) return false;
+      if (Leader != other.Leader) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (PlayerId != 0UL) hash ^= PlayerId.GetHashCode();
+      if (Name.Length != 0) hash ^= Name.GetHashCode();
+      if (Leader != false) hash ^= Leader.GetHashCode();
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Leader != false) {
+        output.WriteRawTag(24);
+        output.WriteBool(Leader);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (PlayerId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(PlayerId);
+      }
+      if (Name.Length != 0) {
+        output.WriteRawTag(18);
+        output.WriteString(Name);
+      }
+      if (Leader != false) {
+        output.WriteRawTag(24);
+        output.WriteBool(Leader);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (PlayerId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(PlayerId);
+      }
+      if (Name.Length != 0) {
+        size += 1 + pb::CodedOutputStream.ComputeStringSize(Name);
+      }
+      if (Leader != false) {
+        size += 1 + 1;
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(SpectateTargetMessage other) {
+      if (other == null) {
+        return;
+      }
+      if (other.PlayerId != 0UL) {
+        PlayerId = other.PlayerId;
+      }
+      if (other.Name.Length != 0) {
+        Name = other.Name;
+      }
+      if (other.Leader != false) {
+        Leader = other.Leader;
+      }
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Leader = input.ReadBool();
+            break;
+          }
+        }
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+            break;
+          case 8: {
+            PlayerId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            Name = input.ReadString();
+            break;
+          }
+          case 24: {
+            Leader = input.ReadBool();
+            break;
+          }
+        }
+      }
+    }
+    #endif
+
+  }
+
+  [global::System.Diagnostics.DebuggerDisplayAttribute("{ToString(),nq}")]
+  public sealed partial class Packet : pb::IMessage<Packet>
+  #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      , pb::IBufferMessage
+  #endif
+  {
+    private static readonly pb::MessageParser<Packet> _parser = new pb::MessageParser<Packet>(() => new Packet());
+    private pb::UnknownFieldSet _unknownFields;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pb::MessageParser<Packet> Parser { get { return _parser; } }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public static pbr::MessageDescriptor Descriptor {
+      get { return global::Client.Packets.PacketsReflection.Descriptor.MessageTypes[59]; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    pbr::MessageDescriptor pb::IMessage.Descriptor {
+      get { return Descriptor; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public Packet() {
+      OnConstruction();
+    }
+
+    partial void OnConstruction();
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public Packet(Packet other) : this() {
+      senderId_ = other.senderId_;
+      switch (other.MsgCase) {
+        case MsgOneofCase.Chat:
+          Chat = other.Chat.Clone();
+          break;
+        case MsgOneofCase.Id:
+          Id = other.Id.Clone();
+          break;
+        case MsgOneofCase.LoginRequest:
+          LoginRequest = other.LoginRequest.Clone();
+          break;
+        case MsgOneofCase.RegisterRequest:
+          RegisterRequest = other.RegisterRequest.Clone();
+          break;
+        case MsgOneofCase.OkResponse:
+          OkResponse = other.OkResponse.Clone();
+          break;
+        case MsgOneofCase.DenyResponse:
+          DenyResponse = other.DenyResponse.Clone();
+          break;
+        case MsgOneofCase.Player:
+          Player = other.Player.Clone();
+          break;
+        case MsgOneofCase.PlayerDirection:
+          PlayerDirection = other.PlayerDirection.Clone();
+          break;
+        case MsgOneofCase.Spore:
+          Spore = other.Spore.Clone();
+          break;
+        case MsgOneofCase.SporeConsumed:
+          SporeConsumed = other.SporeConsumed.Clone();
+          break;
+        case MsgOneofCase.SporeBatch:
+          SporeBatch = other.SporeBatch.Clone();
+          break;
+        case MsgOneofCase.PlayerConsumed:
+          PlayerConsumed = other.PlayerConsumed.Clone();
+          break;
+        case MsgOneofCase.HiscoreBoardRequest:
+          HiscoreBoardRequest = other.HiscoreBoardRequest.Clone();
+          break;
+        case MsgOneofCase.Hiscore:
+          Hiscore = other.Hiscore.Clone();
+          break;
+        case MsgOneofCase.HiscoreBoard:
+          HiscoreBoard = other.HiscoreBoard.Clone();
+          break;
+        case MsgOneofCase.FinishBrowsingHiscores:
+          FinishBrowsingHiscores = other.FinishBrowsingHiscores.Clone();
+          break;
+        case MsgOneofCase.SearchHiscore:
+          SearchHiscore = other.SearchHiscore.Clone();
+          break;
+        case MsgOneofCase.Disconnect:
+          Disconnect = other.Disconnect.Clone();
+          break;
+        case MsgOneofCase.ViewEnter:
+          ViewEnter = other.ViewEnter.Clone();
+          break;
+        case MsgOneofCase.ViewLeave:
+          ViewLeave = other.ViewLeave.Clone();
+          break;
+        case MsgOneofCase.Snapshot:
+          Snapshot = other.Snapshot.Clone();
+          break;
+        case MsgOneofCase.SnapshotAck:
+          SnapshotAck = other.SnapshotAck.Clone();
+          break;
+        case MsgOneofCase.RoomListRequest:
+          RoomListRequest = other.RoomListRequest.Clone();
+          break;
+        case MsgOneofCase.RoomList:
+          RoomList = other.RoomList.Clone();
+          break;
+        case MsgOneofCase.JoinRoomRequest:
+          JoinRoomRequest = other.JoinRoomRequest.Clone();
+          break;
+        case MsgOneofCase.CreateRoomRequest:
+          CreateRoomRequest = other.CreateRoomRequest.Clone();
+          break;
+        case MsgOneofCase.RoomCreated:
+          RoomCreated = other.RoomCreated.Clone();
+          break;
+        case MsgOneofCase.JoinRoomByCodeRequest:
+          JoinRoomByCodeRequest = other.JoinRoomByCodeRequest.Clone();
+          break;
+        case MsgOneofCase.KickPlayerRequest:
+          KickPlayerRequest = other.KickPlayerRequest.Clone();
+          break;
+        case MsgOneofCase.LockRoomRequest:
+          LockRoomRequest = other.LockRoomRequest.Clone();
+          break;
+        case MsgOneofCase.CloseRoomRequest:
+          CloseRoomRequest = other.CloseRoomRequest.Clone();
+          break;
+        case MsgOneofCase.RemovedFromRoom:
+          RemovedFromRoom = other.RemovedFromRoom.Clone();
+          break;
+        case MsgOneofCase.GameOver:
+          GameOver = other.GameOver.Clone();
+          break;
+        case MsgOneofCase.TeamAssignment:
+          TeamAssignment = other.TeamAssignment.Clone();
+          break;
+        case MsgOneofCase.TeamScoreboard:
+          TeamScoreboard = other.TeamScoreboard.Clone();
+          break;
+        case MsgOneofCase.RoundCountdown:
+          RoundCountdown = other.RoundCountdown.Clone();
+          break;
+        case MsgOneofCase.RoundEnd:
+          RoundEnd = other.RoundEnd.Clone();
+          break;
+        case MsgOneofCase.Zone:
+          Zone = other.Zone.Clone();
+          break;
+        case MsgOneofCase.WorldBounds:
+          WorldBounds = other.WorldBounds.Clone();
+          break;
+        case MsgOneofCase.PlayerSplit:
+          PlayerSplit = other.PlayerSplit.Clone();
+          break;
+        case MsgOneofCase.PlayerEject:
+          PlayerEject = other.PlayerEject.Clone();
+          break;
+        case MsgOneofCase.PlayerTarget:
+          PlayerTarget = other.PlayerTarget.Clone();
+          break;
+        case MsgOneofCase.HazardBurst:
+          HazardBurst = other.HazardBurst.Clone();
+          break;
+        case MsgOneofCase.PowerUpCollected:
+          PowerUpCollected = other.PowerUpCollected.Clone();
+          break;
+        case MsgOneofCase.PowerUpExpired:
+          PowerUpExpired = other.PowerUpExpired.Clone();
+          break;
+        case MsgOneofCase.GameMap:
+          GameMap = other.GameMap.Clone();
+          break;
+        case MsgOneofCase.DeathSummary:
+          DeathSummary = other.DeathSummary.Clone();
+          break;
+        case MsgOneofCase.RespawnRequest:
+          RespawnRequest = other.RespawnRequest.Clone();
+          break;
+        case MsgOneofCase.SpectateRoomRequest:
+          SpectateRoomRequest = other.SpectateRoomRequest.Clone();
+          break;
+        case MsgOneofCase.FollowPlayerRequest:
+          FollowPlayerRequest = other.FollowPlayerRequest.Clone();
+          break;
+        case MsgOneofCase.CycleSpectateTargetRequest:
+          CycleSpectateTargetRequest = other.CycleSpectateTargetRequest.Clone();
+          break;
+        case MsgOneofCase.SpectateTarget:
+          SpectateTarget = other.SpectateTarget.Clone();
+          break;
+      }
+
+      _unknownFields = pb::UnknownFieldSet.Clone(other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public Packet Clone() {
+      return new Packet(this);
+    }
+
+    /// <summary>Field number for the "sender_id" field.</summary>
+    public const int SenderIdFieldNumber = 1;
+    private ulong senderId_;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public ulong SenderId {
+      get { return senderId_; }
+      set {
+        senderId_ = value;
+      }
+    }
+
+    /// <summary>Field number for the "chat" field.</summary>
+    public const int ChatFieldNumber = 2;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.ChatMessage Chat {
+      get { return msgCase_ == MsgOneofCase.Chat ? (global::Client.Packets.ChatMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Chat;
+      }
+    }
+
+    /// <summary>Field number for the "id" field.</summary>
+    public const int IdFieldNumber = 3;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.IdMessage Id {
+      get { return msgCase_ == MsgOneofCase.Id ? (global::Client.Packets.IdMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Id;
+      }
+    }
+
+    /// <summary>Field number for the "login_request" field.</summary>
+    public const int LoginRequestFieldNumber = 4;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.LoginRequestMessage LoginRequest {
+      get { return msgCase_ == MsgOneofCase.LoginRequest ? (global::Client.Packets.LoginRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.LoginRequest;
+      }
+    }
+
+    /// <summary>Field number for the "register_request" field.</summary>
+    public const int RegisterRequestFieldNumber = 5;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RegisterRequestMessage RegisterRequest {
+      get { return msgCase_ == MsgOneofCase.RegisterRequest ? (global::Client.Packets.RegisterRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RegisterRequest;
+      }
+    }
+
+    /// <summary>Field number for the "ok_response" field.</summary>
+    public const int OkResponseFieldNumber = 6;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.OkResponseMessage OkResponse {
+      get { return msgCase_ == MsgOneofCase.OkResponse ? (global::Client.Packets.OkResponseMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.OkResponse;
+      }
+    }
+
+    /// <summary>Field number for the "deny_response" field.</summary>
+    public const int DenyResponseFieldNumber = 7;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.DenyResponseMessage DenyResponse {
+      get { return msgCase_ == MsgOneofCase.DenyResponse ? (global::Client.Packets.DenyResponseMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.DenyResponse;
+      }
+    }
+
+    /// <summary>Field number for the "player" field.</summary>
+    public const int PlayerFieldNumber = 8;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PlayerMessage Player {
+      get { return msgCase_ == MsgOneofCase.Player ? (global::Client.Packets.PlayerMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Player;
+      }
+    }
+
+    /// <summary>Field number for the "player_direction" field.</summary>
+    public const int PlayerDirectionFieldNumber = 9;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PlayerDirectionMessage PlayerDirection {
+      get { return msgCase_ == MsgOneofCase.PlayerDirection ? (global::Client.Packets.PlayerDirectionMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PlayerDirection;
+      }
+    }
+
+    /// <summary>Field number for the "spore" field.</summary>
+    public const int SporeFieldNumber = 10;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SporeMessage Spore {
+      get { return msgCase_ == MsgOneofCase.Spore ? (global::Client.Packets.SporeMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Spore;
+      }
+    }
+
+    /// <summary>Field number for the "spore_consumed" field.</summary>
+    public const int SporeConsumedFieldNumber = 11;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SporeConsumedMessage SporeConsumed {
+      get { return msgCase_ == MsgOneofCase.SporeConsumed ? (global::Client.Packets.SporeConsumedMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SporeConsumed;
+      }
+    }
+
+    /// <summary>Field number for the "spore_batch" field.</summary>
+    public const int SporeBatchFieldNumber = 12;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SporeBatchMessage SporeBatch {
+      get { return msgCase_ == MsgOneofCase.SporeBatch ? (global::Client.Packets.SporeBatchMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SporeBatch;
+      }
+    }
+
+    /// <summary>Field number for the "player_consumed" field.</summary>
+    public const int PlayerConsumedFieldNumber = 13;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PlayerConsumedMessage PlayerConsumed {
+      get { return msgCase_ == MsgOneofCase.PlayerConsumed ? (global::Client.Packets.PlayerConsumedMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PlayerConsumed;
+      }
+    }
+
+    /// <summary>Field number for the "hiscore_board_request" field.</summary>
+    public const int HiscoreBoardRequestFieldNumber = 14;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.HiscoreBoardRequestMessage HiscoreBoardRequest {
+      get { return msgCase_ == MsgOneofCase.HiscoreBoardRequest ? (global::Client.Packets.HiscoreBoardRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.HiscoreBoardRequest;
+      }
+    }
+
+    /// <summary>Field number for the "hiscore" field.</summary>
+    public const int HiscoreFieldNumber = 15;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.HiscoreMessage Hiscore {
+      get { return msgCase_ == MsgOneofCase.Hiscore ? (global::Client.Packets.HiscoreMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Hiscore;
+      }
+    }
+
+    /// <summary>Field number for the "hiscore_board" field.</summary>
+    public const int HiscoreBoardFieldNumber = 16;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.HiscoreBoardMessage HiscoreBoard {
+      get { return msgCase_ == MsgOneofCase.HiscoreBoard ? (global::Client.Packets.HiscoreBoardMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.HiscoreBoard;
+      }
+    }
+
+    /// <summary>Field number for the "finish_browsing_hiscores" field.</summary>
+    public const int FinishBrowsingHiscoresFieldNumber = 17;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.FinishedBrowsingHiscoresMessage FinishBrowsingHiscores {
+      get { return msgCase_ == MsgOneofCase.FinishBrowsingHiscores ? (global::Client.Packets.FinishedBrowsingHiscoresMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.FinishBrowsingHiscores;
+      }
+    }
+
+    /// <summary>Field number for the "search_hiscore" field.</summary>
+    public const int SearchHiscoreFieldNumber = 18;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SearchHiscoreMessage SearchHiscore {
+      get { return msgCase_ == MsgOneofCase.SearchHiscore ? (global::Client.Packets.SearchHiscoreMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SearchHiscore;
+      }
+    }
+
+    /// <summary>Field number for the "disconnect" field.</summary>
+    public const int DisconnectFieldNumber = 19;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.DisconnectMessage Disconnect {
+      get { return msgCase_ == MsgOneofCase.Disconnect ? (global::Client.Packets.DisconnectMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Disconnect;
+      }
+    }
+
+    /// <summary>Field number for the "view_enter" field.</summary>
+    public const int ViewEnterFieldNumber = 20;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.ViewEnterMessage ViewEnter {
+      get { return msgCase_ == MsgOneofCase.ViewEnter ? (global::Client.Packets.ViewEnterMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.ViewEnter;
+      }
+    }
+
+    /// <summary>Field number for the "view_leave" field.</summary>
+    public const int ViewLeaveFieldNumber = 21;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.ViewLeaveMessage ViewLeave {
+      get { return msgCase_ == MsgOneofCase.ViewLeave ? (global::Client.Packets.ViewLeaveMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.ViewLeave;
+      }
+    }
+
+    /// <summary>Field number for the "snapshot" field.</summary>
+    public const int SnapshotFieldNumber = 22;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SnapshotMessage Snapshot {
+      get { return msgCase_ == MsgOneofCase.Snapshot ? (global::Client.Packets.SnapshotMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Snapshot;
+      }
+    }
+
+    /// <summary>Field number for the "snapshot_ack" field.</summary>
+    public const int SnapshotAckFieldNumber = 23;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SnapshotAckMessage SnapshotAck {
+      get { return msgCase_ == MsgOneofCase.SnapshotAck ? (global::Client.Packets.SnapshotAckMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SnapshotAck;
+      }
+    }
+
+    /// <summary>Field number for the "room_list_request" field.</summary>
+    public const int RoomListRequestFieldNumber = 24;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RoomListRequestMessage RoomListRequest {
+      get { return msgCase_ == MsgOneofCase.RoomListRequest ? (global::Client.Packets.RoomListRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RoomListRequest;
+      }
+    }
+
+    /// <summary>Field number for the "room_list" field.</summary>
+    public const int RoomListFieldNumber = 25;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RoomListMessage RoomList {
+      get { return msgCase_ == MsgOneofCase.RoomList ? (global::Client.Packets.RoomListMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RoomList;
+      }
+    }
+
+    /// <summary>Field number for the "join_room_request" field.</summary>
+    public const int JoinRoomRequestFieldNumber = 26;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.JoinRoomRequestMessage JoinRoomRequest {
+      get { return msgCase_ == MsgOneofCase.JoinRoomRequest ? (global::Client.Packets.JoinRoomRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.JoinRoomRequest;
+      }
+    }
+
+    /// <summary>Field number for the "create_room_request" field.</summary>
+    public const int CreateRoomRequestFieldNumber = 27;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.CreateRoomRequestMessage CreateRoomRequest {
+      get { return msgCase_ == MsgOneofCase.CreateRoomRequest ? (global::Client.Packets.CreateRoomRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.CreateRoomRequest;
+      }
+    }
+
+    /// <summary>Field number for the "room_created" field.</summary>
+    public const int RoomCreatedFieldNumber = 28;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RoomCreatedMessage RoomCreated {
+      get { return msgCase_ == MsgOneofCase.RoomCreated ? (global::Client.Packets.RoomCreatedMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RoomCreated;
+      }
+    }
+
+    /// <summary>Field number for the "join_room_by_code_request" field.</summary>
+    public const int JoinRoomByCodeRequestFieldNumber = 29;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.JoinRoomByCodeRequestMessage JoinRoomByCodeRequest {
+      get { return msgCase_ == MsgOneofCase.JoinRoomByCodeRequest ? (global::Client.Packets.JoinRoomByCodeRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.JoinRoomByCodeRequest;
+      }
+    }
+
+    /// <summary>Field number for the "kick_player_request" field.</summary>
+    public const int KickPlayerRequestFieldNumber = 30;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.KickPlayerRequestMessage KickPlayerRequest {
+      get { return msgCase_ == MsgOneofCase.KickPlayerRequest ? (global::Client.Packets.KickPlayerRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.KickPlayerRequest;
+      }
+    }
+
+    /// <summary>Field number for the "lock_room_request" field.</summary>
+    public const int LockRoomRequestFieldNumber = 31;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.LockRoomRequestMessage LockRoomRequest {
+      get { return msgCase_ == MsgOneofCase.LockRoomRequest ? (global::Client.Packets.LockRoomRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.LockRoomRequest;
+      }
+    }
+
+    /// <summary>Field number for the "close_room_request" field.</summary>
+    public const int CloseRoomRequestFieldNumber = 32;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.CloseRoomRequestMessage CloseRoomRequest {
+      get { return msgCase_ == MsgOneofCase.CloseRoomRequest ? (global::Client.Packets.CloseRoomRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.CloseRoomRequest;
+      }
+    }
+
+    /// <summary>Field number for the "removed_from_room" field.</summary>
+    public const int RemovedFromRoomFieldNumber = 33;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RemovedFromRoomMessage RemovedFromRoom {
+      get { return msgCase_ == MsgOneofCase.RemovedFromRoom ? (global::Client.Packets.RemovedFromRoomMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RemovedFromRoom;
+      }
+    }
+
+    /// <summary>Field number for the "game_over" field.</summary>
+    public const int GameOverFieldNumber = 34;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.GameOverMessage GameOver {
+      get { return msgCase_ == MsgOneofCase.GameOver ? (global::Client.Packets.GameOverMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.GameOver;
+      }
+    }
+
+    /// <summary>Field number for the "team_assignment" field.</summary>
+    public const int TeamAssignmentFieldNumber = 35;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.TeamAssignmentMessage TeamAssignment {
+      get { return msgCase_ == MsgOneofCase.TeamAssignment ? (global::Client.Packets.TeamAssignmentMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.TeamAssignment;
+      }
+    }
+
+    /// <summary>Field number for the "team_scoreboard" field.</summary>
+    public const int TeamScoreboardFieldNumber = 36;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.TeamScoreboardMessage TeamScoreboard {
+      get { return msgCase_ == MsgOneofCase.TeamScoreboard ? (global::Client.Packets.TeamScoreboardMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.TeamScoreboard;
+      }
+    }
+
+    /// <summary>Field number for the "round_countdown" field.</summary>
+    public const int RoundCountdownFieldNumber = 37;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RoundCountdownMessage RoundCountdown {
+      get { return msgCase_ == MsgOneofCase.RoundCountdown ? (global::Client.Packets.RoundCountdownMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RoundCountdown;
+      }
+    }
+
+    /// <summary>Field number for the "round_end" field.</summary>
+    public const int RoundEndFieldNumber = 38;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RoundEndMessage RoundEnd {
+      get { return msgCase_ == MsgOneofCase.RoundEnd ? (global::Client.Packets.RoundEndMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RoundEnd;
+      }
+    }
+
+    /// <summary>Field number for the "zone" field.</summary>
+    public const int ZoneFieldNumber = 39;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.ZoneMessage Zone {
+      get { return msgCase_ == MsgOneofCase.Zone ? (global::Client.Packets.ZoneMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.Zone;
+      }
+    }
+
+    /// <summary>Field number for the "world_bounds" field.</summary>
+    public const int WorldBoundsFieldNumber = 40;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.WorldBoundsMessage WorldBounds {
+      get { return msgCase_ == MsgOneofCase.WorldBounds ? (global::Client.Packets.WorldBoundsMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.WorldBounds;
+      }
+    }
+
+    /// <summary>Field number for the "player_split" field.</summary>
+    public const int PlayerSplitFieldNumber = 41;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PlayerSplitMessage PlayerSplit {
+      get { return msgCase_ == MsgOneofCase.PlayerSplit ? (global::Client.Packets.PlayerSplitMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PlayerSplit;
+      }
+    }
+
+    /// <summary>Field number for the "player_eject" field.</summary>
+    public const int PlayerEjectFieldNumber = 42;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PlayerEjectMessage PlayerEject {
+      get { return msgCase_ == MsgOneofCase.PlayerEject ? (global::Client.Packets.PlayerEjectMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PlayerEject;
+      }
+    }
+
+    /// <summary>Field number for the "player_target" field.</summary>
+    public const int PlayerTargetFieldNumber = 43;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PlayerTargetMessage PlayerTarget {
+      get { return msgCase_ == MsgOneofCase.PlayerTarget ? (global::Client.Packets.PlayerTargetMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PlayerTarget;
+      }
+    }
+
+    /// <summary>Field number for the "hazard_burst" field.</summary>
+    public const int HazardBurstFieldNumber = 44;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.HazardBurstMessage HazardBurst {
+      get { return msgCase_ == MsgOneofCase.HazardBurst ? (global::Client.Packets.HazardBurstMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.HazardBurst;
+      }
+    }
+
+    /// <summary>Field number for the "power_up_collected" field.</summary>
+    public const int PowerUpCollectedFieldNumber = 45;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PowerUpCollectedMessage PowerUpCollected {
+      get { return msgCase_ == MsgOneofCase.PowerUpCollected ? (global::Client.Packets.PowerUpCollectedMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PowerUpCollected;
+      }
+    }
+
+    /// <summary>Field number for the "power_up_expired" field.</summary>
+    public const int PowerUpExpiredFieldNumber = 46;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.PowerUpExpiredMessage PowerUpExpired {
+      get { return msgCase_ == MsgOneofCase.PowerUpExpired ? (global::Client.Packets.PowerUpExpiredMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.PowerUpExpired;
+      }
+    }
+
+    /// <summary>Field number for the "game_map" field.</summary>
+    public const int GameMapFieldNumber = 47;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.GameMapMessage GameMap {
+      get { return msgCase_ == MsgOneofCase.GameMap ? (global::Client.Packets.GameMapMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.GameMap;
+      }
+    }
+
+    /// <summary>Field number for the "death_summary" field.</summary>
+    public const int DeathSummaryFieldNumber = 48;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.DeathSummaryMessage DeathSummary {
+      get { return msgCase_ == MsgOneofCase.DeathSummary ? (global::Client.Packets.DeathSummaryMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.DeathSummary;
+      }
+    }
+
+    /// <summary>Field number for the "respawn_request" field.</summary>
+    public const int RespawnRequestFieldNumber = 49;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.RespawnRequestMessage RespawnRequest {
+      get { return msgCase_ == MsgOneofCase.RespawnRequest ? (global::Client.Packets.RespawnRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.RespawnRequest;
+      }
+    }
+
+    /// <summary>Field number for the "spectate_room_request" field.</summary>
+    public const int SpectateRoomRequestFieldNumber = 50;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SpectateRoomRequestMessage SpectateRoomRequest {
+      get { return msgCase_ == MsgOneofCase.SpectateRoomRequest ? (global::Client.Packets.SpectateRoomRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SpectateRoomRequest;
+      }
+    }
+
+    /// <summary>Field number for the "follow_player_request" field.</summary>
+    public const int FollowPlayerRequestFieldNumber = 51;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.FollowPlayerRequestMessage FollowPlayerRequest {
+      get { return msgCase_ == MsgOneofCase.FollowPlayerRequest ? (global::Client.Packets.FollowPlayerRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.FollowPlayerRequest;
+      }
+    }
+
+    /// <summary>Field number for the "cycle_spectate_target_request" field.</summary>
+    public const int CycleSpectateTargetRequestFieldNumber = 52;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.CycleSpectateTargetRequestMessage CycleSpectateTargetRequest {
+      get { return msgCase_ == MsgOneofCase.CycleSpectateTargetRequest ? (global::Client.Packets.CycleSpectateTargetRequestMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.CycleSpectateTargetRequest;
+      }
+    }
+
+    /// <summary>Field number for the "spectate_target" field.</summary>
+    public const int SpectateTargetFieldNumber = 53;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public global::Client.Packets.SpectateTargetMessage SpectateTarget {
+      get { return msgCase_ == MsgOneofCase.SpectateTarget ? (global::Client.Packets.SpectateTargetMessage) msg_ : null; }
+      set {
+        msg_ = value;
+        msgCase_ = value == null ? MsgOneofCase.None : MsgOneofCase.SpectateTarget;
+      }
+    }
+
+    private object msg_;
+    /// <summary>Enum of possible cases for the "msg" oneof.</summary>
+    public enum MsgOneofCase {
+      None = 0,
+      Chat = 2,
+      Id = 3,
+      LoginRequest = 4,
+      RegisterRequest = 5,
+      OkResponse = 6,
+      DenyResponse = 7,
+      Player = 8,
+      PlayerDirection = 9,
+      Spore = 10,
+      SporeConsumed = 11,
+      SporeBatch = 12,
+      PlayerConsumed = 13,
+      HiscoreBoardRequest = 14,
+      Hiscore = 15,
+      HiscoreBoard = 16,
+      FinishBrowsingHiscores = 17,
+      SearchHiscore = 18,
+      Disconnect = 19,
+      ViewEnter = 20,
+      ViewLeave = 21,
+      Snapshot = 22,
+      SnapshotAck = 23,
+      RoomListRequest = 24,
+      RoomList = 25,
+      JoinRoomRequest = 26,
+      CreateRoomRequest = 27,
+      RoomCreated = 28,
+      JoinRoomByCodeRequest = 29,
+      KickPlayerRequest = 30,
+      LockRoomRequest = 31,
+      CloseRoomRequest = 32,
+      RemovedFromRoom = 33,
+      GameOver = 34,
+      TeamAssignment = 35,
+      TeamScoreboard = 36,
+      RoundCountdown = 37,
+      RoundEnd = 38,
+      Zone = 39,
+      WorldBounds = 40,
+      PlayerSplit = 41,
+      PlayerEject = 42,
+      PlayerTarget = 43,
+      HazardBurst = 44,
+      PowerUpCollected = 45,
+      PowerUpExpired = 46,
+      GameMap = 47,
+      DeathSummary = 48,
+      RespawnRequest = 49,
+      SpectateRoomRequest = 50,
+      FollowPlayerRequest = 51,
+      CycleSpectateTargetRequest = 52,
+      SpectateTarget = 53,
+    }
+    private MsgOneofCase msgCase_ = MsgOneofCase.None;
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public MsgOneofCase MsgCase {
+      get { return msgCase_; }
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void ClearMsg() {
+      msgCase_ = MsgOneofCase.None;
+      msg_ = null;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override bool Equals(object other) {
+      return Equals(other as Packet);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public bool Equals(Packet other) {
+      if (ReferenceEquals(other, null)) {
+        return false;
+      }
+      if (ReferenceEquals(other, this)) {
+        return true;
+      }
+      if (SenderId != other.SenderId) return false;
+      if (!object.Equals(Chat, other.Chat)) return false;
+      if (!object.Equals(Id, other.Id)) return false;
+      if (!object.Equals(LoginRequest, other.LoginRequest)) return false;
+      if (!object.Equals(RegisterRequest, other.RegisterRequest)) return false;
+      if (!object.Equals(OkResponse, other.OkResponse)) return false;
+      if (!object.Equals(DenyResponse, other.DenyResponse)) return false;
+      if (!object.Equals(Player, other.Player)) return false;
+      if (!object.Equals(PlayerDirection, other.PlayerDirection)) return false;
+      if (!object.Equals(Spore, other.Spore)) return false;
+      if (!object.Equals(SporeConsumed, other.SporeConsumed)) return false;
+      if (!object.Equals(SporeBatch, other.SporeBatch)) return false;
+      if (!object.Equals(PlayerConsumed, other.PlayerConsumed)) return false;
+      if (!object.Equals(HiscoreBoardRequest, other.HiscoreBoardRequest)) return false;
+      if (!object.Equals(Hiscore, other.Hiscore)) return false;
+      if (!object.Equals(HiscoreBoard, other.HiscoreBoard)) return false;
+      if (!object.Equals(FinishBrowsingHiscores, other.FinishBrowsingHiscores)) return false;
+      if (!object.Equals(SearchHiscore, other.SearchHiscore)) return false;
+      if (!object.Equals(Disconnect, other.Disconnect)) return false;
+      if (!object.Equals(ViewEnter, other.ViewEnter)) return false;
+      if (!object.Equals(ViewLeave, other.ViewLeave)) return false;
+      if (!object.Equals(Snapshot, other.Snapshot)) return false;
+      if (!object.Equals(SnapshotAck, other.SnapshotAck)) return false;
+      if (!object.Equals(RoomListRequest, other.RoomListRequest)) return false;
+      if (!object.Equals(RoomList, other.RoomList)) return false;
+      if (!object.Equals(JoinRoomRequest, other.JoinRoomRequest)) return false;
+      if (!object.Equals(CreateRoomRequest, other.CreateRoomRequest)) return false;
+      if (!object.Equals(RoomCreated, other.RoomCreated)) return false;
+      if (!object.Equals(JoinRoomByCodeRequest, other.JoinRoomByCodeRequest)) return false;
+      if (!object.Equals(KickPlayerRequest, other.KickPlayerRequest)) return false;
+      if (!object.Equals(LockRoomRequest, other.LockRoomRequest)) return false;
+      if (!object.Equals(CloseRoomRequest, other.CloseRoomRequest)) return false;
+      if (!object.Equals(RemovedFromRoom, other.RemovedFromRoom)) return false;
+      if (!object.Equals(GameOver, other.GameOver)) return false;
+      if (!object.Equals(TeamAssignment, other.TeamAssignment)) return false;
+      if (!object.Equals(TeamScoreboard, other.TeamScoreboard)) return false;
+      if (!object.Equals(RoundCountdown, other.RoundCountdown)) return false;
+      if (!object.Equals(RoundEnd, other.RoundEnd)) return false;
+      if (!object.Equals(Zone, other.Zone)) return false;
+      if (!object.Equals(WorldBounds, other.WorldBounds)) return false;
+      if (!object.Equals(PlayerSplit, other.PlayerSplit)) return false;
+      if (!object.Equals(PlayerEject, other.PlayerEject)) return false;
+      if (!object.Equals(PlayerTarget, other.PlayerTarget)) return false;
+      if (!object.Equals(HazardBurst, other.HazardBurst)) return false;
+      if (!object.Equals(PowerUpCollected, other.PowerUpCollected)) return false;
+      if (!object.Equals(PowerUpExpired, other.PowerUpExpired)) return false;
+      if (!object.Equals(GameMap, other.GameMap)) return false;
+      if (!object.Equals(DeathSummary, other.DeathSummary)) return false;
+      if (!object.Equals(RespawnRequest, other.RespawnRequest)) return false;
+      if (!object.Equals(SpectateRoomRequest, other.SpectateRoomRequest)) return false;
+      if (!object.Equals(FollowPlayerRequest, other.FollowPlayerRequest)) return false;
+      if (!object.Equals(CycleSpectateTargetRequest, other.CycleSpectateTargetRequest)) return false;
+      if (!object.Equals(SpectateTarget, other.SpectateTarget)) return false;
+      if (MsgCase != other.MsgCase) return false;
+      return Equals(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override int GetHashCode() {
+      int hash = 1;
+      if (SenderId != 0UL) hash ^= SenderId.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Chat) hash ^= Chat.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Id) hash ^= Id.GetHashCode();
+      if (msgCase_ == MsgOneofCase.LoginRequest) hash ^= LoginRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RegisterRequest) hash ^= RegisterRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.OkResponse) hash ^= OkResponse.GetHashCode();
+      if (msgCase_ == MsgOneofCase.DenyResponse) hash ^= DenyResponse.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Player) hash ^= Player.GetHashCode();
+      if (msgCase_ == MsgOneofCase.PlayerDirection) hash ^= PlayerDirection.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Spore) hash ^= Spore.GetHashCode();
+      if (msgCase_ == MsgOneofCase.SporeConsumed) hash ^= SporeConsumed.GetHashCode();
+      if (msgCase_ == MsgOneofCase.SporeBatch) hash ^= SporeBatch.GetHashCode();
+      if (msgCase_ == MsgOneofCase.PlayerConsumed) hash ^= PlayerConsumed.GetHashCode();
+      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) hash ^= HiscoreBoardRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Hiscore) hash ^= Hiscore.GetHashCode();
+      if (msgCase_ == MsgOneofCase.HiscoreBoard) hash ^= HiscoreBoard.GetHashCode();
+      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) hash ^= FinishBrowsingHiscores.GetHashCode();
+      if (msgCase_ == MsgOneofCase.SearchHiscore) hash ^= SearchHiscore.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Disconnect) hash ^= Disconnect.GetHashCode();
+      if (msgCase_ == MsgOneofCase.ViewEnter) hash ^= ViewEnter.GetHashCode();
+      if (msgCase_ == MsgOneofCase.ViewLeave) hash ^= ViewLeave.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Snapshot) hash ^= Snapshot.GetHashCode();
+      if (msgCase_ == MsgOneofCase.SnapshotAck) hash ^= SnapshotAck.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RoomListRequest) hash ^= RoomListRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RoomList) hash ^= RoomList.GetHashCode();
+      if (msgCase_ == MsgOneofCase.JoinRoomRequest) hash ^= JoinRoomRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.CreateRoomRequest) hash ^= CreateRoomRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RoomCreated) hash ^= RoomCreated.GetHashCode();
+      if (msgCase_ == MsgOneofCase.JoinRoomByCodeRequest) hash ^= JoinRoomByCodeRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.KickPlayerRequest) hash ^= KickPlayerRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.LockRoomRequest) hash ^= LockRoomRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.CloseRoomRequest) hash ^= CloseRoomRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RemovedFromRoom) hash ^= RemovedFromRoom.GetHashCode();
+      if (msgCase_ == MsgOneofCase.GameOver) hash ^= GameOver.GetHashCode();
+      if (msgCase_ == MsgOneofCase.TeamAssignment) hash ^= TeamAssignment.GetHashCode();
+      if (msgCase_ == MsgOneofCase.TeamScoreboard) hash ^= TeamScoreboard.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RoundCountdown) hash ^= RoundCountdown.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RoundEnd) hash ^= RoundEnd.GetHashCode();
+      if (msgCase_ == MsgOneofCase.Zone) hash ^= Zone.GetHashCode();
+      if (msgCase_ == MsgOneofCase.WorldBounds) hash ^= WorldBounds.GetHashCode();
+      if (msgCase_ == MsgOneofCase.PlayerSplit) hash ^= PlayerSplit.GetHashCode();
+      if (msgCase_ == MsgOneofCase.PlayerEject) hash ^= PlayerEject.GetHashCode();
+      if (msgCase_ == MsgOneofCase.PlayerTarget) hash ^= PlayerTarget.GetHashCode();
+      if (msgCase_ == MsgOneofCase.HazardBurst) hash ^= HazardBurst.GetHashCode();
+      if (msgCase_ == MsgOneofCase.PowerUpCollected) hash ^= PowerUpCollected.GetHashCode();
+      if (msgCase_ == MsgOneofCase.PowerUpExpired) hash ^= PowerUpExpired.GetHashCode();
+      if (msgCase_ == MsgOneofCase.GameMap) hash ^= GameMap.GetHashCode();
+      if (msgCase_ == MsgOneofCase.DeathSummary) hash ^= DeathSummary.GetHashCode();
+      if (msgCase_ == MsgOneofCase.RespawnRequest) hash ^= RespawnRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.SpectateRoomRequest) hash ^= SpectateRoomRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.FollowPlayerRequest) hash ^= FollowPlayerRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.CycleSpectateTargetRequest) hash ^= CycleSpectateTargetRequest.GetHashCode();
+      if (msgCase_ == MsgOneofCase.SpectateTarget) hash ^= SpectateTarget.GetHashCode();
+      hash ^= (int) msgCase_;
+      if (_unknownFields != null) {
+        hash ^= _unknownFields.GetHashCode();
+      }
+      return hash;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public override string ToString() {
+      return pb::JsonFormatter.ToDiagnosticString(this);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void WriteTo(pb::CodedOutputStream output) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      output.WriteRawMessage(this);
+    #else
+      if (SenderId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(SenderId);
+      }
+      if (msgCase_ == MsgOneofCase.Chat) {
+        output.WriteRawTag(18);
+        output.WriteMessage(Chat);
+      }
+      if (msgCase_ == MsgOneofCase.Id) {
+        output.WriteRawTag(26);
+        output.WriteMessage(Id);
+      }
+      if (msgCase_ == MsgOneofCase.LoginRequest) {
+        output.WriteRawTag(34);
+        output.WriteMessage(LoginRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RegisterRequest) {
+        output.WriteRawTag(42);
+        output.WriteMessage(RegisterRequest);
+      }
+      if (msgCase_ == MsgOneofCase.OkResponse) {
+        output.WriteRawTag(50);
+        output.WriteMessage(OkResponse);
+      }
+      if (msgCase_ == MsgOneofCase.DenyResponse) {
+        output.WriteRawTag(58);
+        output.WriteMessage(DenyResponse);
+      }
+      if (msgCase_ == MsgOneofCase.Player) {
+        output.WriteRawTag(66);
+        output.WriteMessage(Player);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerDirection) {
+        output.WriteRawTag(74);
+        output.WriteMessage(PlayerDirection);
+      }
+      if (msgCase_ == MsgOneofCase.Spore) {
+        output.WriteRawTag(82);
+        output.WriteMessage(Spore);
+      }
+      if (msgCase_ == MsgOneofCase.SporeConsumed) {
+        output.WriteRawTag(90);
+        output.WriteMessage(SporeConsumed);
+      }
+      if (msgCase_ == MsgOneofCase.SporeBatch) {
+        output.WriteRawTag(98);
+        output.WriteMessage(SporeBatch);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerConsumed) {
+        output.WriteRawTag(106);
+        output.WriteMessage(PlayerConsumed);
+      }
+      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
+        output.WriteRawTag(114);
+        output.WriteMessage(HiscoreBoardRequest);
+      }
+      if (msgCase_ == MsgOneofCase.Hiscore) {
+        output.WriteRawTag(122);
+        output.WriteMessage(Hiscore);
+      }
+      if (msgCase_ == MsgOneofCase.HiscoreBoard) {
+        output.WriteRawTag(130, 1);
+        output.WriteMessage(HiscoreBoard);
+      }
+      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
+        output.WriteRawTag(138, 1);
+        output.WriteMessage(FinishBrowsingHiscores);
+      }
+      if (msgCase_ == MsgOneofCase.SearchHiscore) {
+        output.WriteRawTag(146, 1);
+        output.WriteMessage(SearchHiscore);
+      }
+      if (msgCase_ == MsgOneofCase.Disconnect) {
+        output.WriteRawTag(154, 1);
+        output.WriteMessage(Disconnect);
+      }
+      if (msgCase_ == MsgOneofCase.ViewEnter) {
+        output.WriteRawTag(162, 1);
+        output.WriteMessage(ViewEnter);
+      }
+      if (msgCase_ == MsgOneofCase.ViewLeave) {
+        output.WriteRawTag(170, 1);
+        output.WriteMessage(ViewLeave);
+      }
+      if (msgCase_ == MsgOneofCase.Snapshot) {
+        output.WriteRawTag(178, 1);
+        output.WriteMessage(Snapshot);
+      }
+      if (msgCase_ == MsgOneofCase.SnapshotAck) {
+        output.WriteRawTag(186, 1);
+        output.WriteMessage(SnapshotAck);
+      }
+      if (msgCase_ == MsgOneofCase.RoomListRequest) {
+        output.WriteRawTag(194, 1);
+        output.WriteMessage(RoomListRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RoomList) {
+        output.WriteRawTag(202, 1);
+        output.WriteMessage(RoomList);
+      }
+      if (msgCase_ == MsgOneofCase.JoinRoomRequest) {
+        output.WriteRawTag(210, 1);
+        output.WriteMessage(JoinRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CreateRoomRequest) {
+        output.WriteRawTag(218, 1);
+        output.WriteMessage(CreateRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RoomCreated) {
+        output.WriteRawTag(226, 1);
+        output.WriteMessage(RoomCreated);
+      }
+      if (msgCase_ == MsgOneofCase.JoinRoomByCodeRequest) {
+        output.WriteRawTag(234, 1);
+        output.WriteMessage(JoinRoomByCodeRequest);
+      }
+      if (msgCase_ == MsgOneofCase.KickPlayerRequest) {
+        output.WriteRawTag(242, 1);
+        output.WriteMessage(KickPlayerRequest);
+      }
+      if (msgCase_ == MsgOneofCase.LockRoomRequest) {
+        output.WriteRawTag(250, 1);
+        output.WriteMessage(LockRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CloseRoomRequest) {
+        output.WriteRawTag(130, 2);
+        output.WriteMessage(CloseRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RemovedFromRoom) {
+        output.WriteRawTag(138, 2);
+        output.WriteMessage(RemovedFromRoom);
+      }
+      if (msgCase_ == MsgOneofCase.GameOver) {
+        output.WriteRawTag(146, 2);
+        output.WriteMessage(GameOver);
+      }
+      if (msgCase_ == MsgOneofCase.TeamAssignment) {
+        output.WriteRawTag(154, 2);
+        output.WriteMessage(TeamAssignment);
+      }
+      if (msgCase_ == MsgOneofCase.TeamScoreboard) {
+        output.WriteRawTag(162, 2);
+        output.WriteMessage(TeamScoreboard);
+      }
+      if (msgCase_ == MsgOneofCase.RoundCountdown) {
+        output.WriteRawTag(170, 2);
+        output.WriteMessage(RoundCountdown);
+      }
+      if (msgCase_ == MsgOneofCase.RoundEnd) {
+        output.WriteRawTag(178, 2);
+        output.WriteMessage(RoundEnd);
+      }
+      if (msgCase_ == MsgOneofCase.Zone) {
+        output.WriteRawTag(186, 2);
+        output.WriteMessage(Zone);
+      }
+      if (msgCase_ == MsgOneofCase.WorldBounds) {
+        output.WriteRawTag(194, 2);
+        output.WriteMessage(WorldBounds);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerSplit) {
+        output.WriteRawTag(202, 2);
+        output.WriteMessage(PlayerSplit);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerEject) {
+        output.WriteRawTag(210, 2);
+        output.WriteMessage(PlayerEject);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerTarget) {
+        output.WriteRawTag(218, 2);
+        output.WriteMessage(PlayerTarget);
+      }
+      if (msgCase_ == MsgOneofCase.HazardBurst) {
+        output.WriteRawTag(226, 2);
+        output.WriteMessage(HazardBurst);
+      }
+      if (msgCase_ == MsgOneofCase.PowerUpCollected) {
+        output.WriteRawTag(234, 2);
+        output.WriteMessage(PowerUpCollected);
+      }
+      if (msgCase_ == MsgOneofCase.PowerUpExpired) {
+        output.WriteRawTag(242, 2);
+        output.WriteMessage(PowerUpExpired);
+      }
+      if (msgCase_ == MsgOneofCase.GameMap) {
+        output.WriteRawTag(250, 2);
+        output.WriteMessage(GameMap);
+      }
+      if (msgCase_ == MsgOneofCase.DeathSummary) {
+        output.WriteRawTag(130, 3);
+        output.WriteMessage(DeathSummary);
+      }
+      if (msgCase_ == MsgOneofCase.RespawnRequest) {
+        output.WriteRawTag(138, 3);
+        output.WriteMessage(RespawnRequest);
+      }
+      if (msgCase_ == MsgOneofCase.SpectateRoomRequest) {
+        output.WriteRawTag(146, 3);
+        output.WriteMessage(SpectateRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.FollowPlayerRequest) {
+        output.WriteRawTag(154, 3);
+        output.WriteMessage(FollowPlayerRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CycleSpectateTargetRequest) {
+        output.WriteRawTag(162, 3);
+        output.WriteMessage(CycleSpectateTargetRequest);
+      }
+      if (msgCase_ == MsgOneofCase.SpectateTarget) {
+        output.WriteRawTag(170, 3);
+        output.WriteMessage(SpectateTarget);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(output);
+      }
+    #endif
+    }
+
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    void pb::IBufferMessage.InternalWriteTo(ref pb::WriteContext output) {
+      if (SenderId != 0UL) {
+        output.WriteRawTag(8);
+        output.WriteUInt64(SenderId);
+      }
+      if (msgCase_ == MsgOneofCase.Chat) {
+        output.WriteRawTag(18);
+        output.WriteMessage(Chat);
+      }
+      if (msgCase_ == MsgOneofCase.Id) {
+        output.WriteRawTag(26);
+        output.WriteMessage(Id);
+      }
+      if (msgCase_ == MsgOneofCase.LoginRequest) {
+        output.WriteRawTag(34);
+        output.WriteMessage(LoginRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RegisterRequest) {
+        output.WriteRawTag(42);
+        output.WriteMessage(RegisterRequest);
+      }
+      if (msgCase_ == MsgOneofCase.OkResponse) {
+        output.WriteRawTag(50);
+        output.WriteMessage(OkResponse);
+      }
+      if (msgCase_ == MsgOneofCase.DenyResponse) {
+        output.WriteRawTag(58);
+        output.WriteMessage(DenyResponse);
+      }
+      if (msgCase_ == MsgOneofCase.Player) {
+        output.WriteRawTag(66);
+        output.WriteMessage(Player);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerDirection) {
+        output.WriteRawTag(74);
+        output.WriteMessage(PlayerDirection);
+      }
+      if (msgCase_ == MsgOneofCase.Spore) {
+        output.WriteRawTag(82);
+        output.WriteMessage(Spore);
+      }
+      if (msgCase_ == MsgOneofCase.SporeConsumed) {
+        output.WriteRawTag(90);
+        output.WriteMessage(SporeConsumed);
+      }
+      if (msgCase_ == MsgOneofCase.SporeBatch) {
+        output.WriteRawTag(98);
+        output.WriteMessage(SporeBatch);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerConsumed) {
+        output.WriteRawTag(106);
+        output.WriteMessage(PlayerConsumed);
+      }
+      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
+        output.WriteRawTag(114);
+        output.WriteMessage(HiscoreBoardRequest);
+      }
+      if (msgCase_ == MsgOneofCase.Hiscore) {
+        output.WriteRawTag(122);
+        output.WriteMessage(Hiscore);
+      }
+      if (msgCase_ == MsgOneofCase.HiscoreBoard) {
+        output.WriteRawTag(130, 1);
+        output.WriteMessage(HiscoreBoard);
+      }
+      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
+        output.WriteRawTag(138, 1);
+        output.WriteMessage(FinishBrowsingHiscores);
+      }
+      if (msgCase_ == MsgOneofCase.SearchHiscore) {
+        output.WriteRawTag(146, 1);
+        output.WriteMessage(SearchHiscore);
+      }
+      if (msgCase_ == MsgOneofCase.Disconnect) {
+        output.WriteRawTag(154, 1);
+        output.WriteMessage(Disconnect);
+      }
+      if (msgCase_ == MsgOneofCase.ViewEnter) {
+        output.WriteRawTag(162, 1);
+        output.WriteMessage(ViewEnter);
+      }
+      if (msgCase_ == MsgOneofCase.ViewLeave) {
+        output.WriteRawTag(170, 1);
+        output.WriteMessage(ViewLeave);
+      }
+      if (msgCase_ == MsgOneofCase.Snapshot) {
+        output.WriteRawTag(178, 1);
+        output.WriteMessage(Snapshot);
+      }
+      if (msgCase_ == MsgOneofCase.SnapshotAck) {
+        output.WriteRawTag(186, 1);
+        output.WriteMessage(SnapshotAck);
+      }
+      if (msgCase_ == MsgOneofCase.RoomListRequest) {
+        output.WriteRawTag(194, 1);
+        output.WriteMessage(RoomListRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RoomList) {
+        output.WriteRawTag(202, 1);
+        output.WriteMessage(RoomList);
+      }
+      if (msgCase_ == MsgOneofCase.JoinRoomRequest) {
+        output.WriteRawTag(210, 1);
+        output.WriteMessage(JoinRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CreateRoomRequest) {
+        output.WriteRawTag(218, 1);
+        output.WriteMessage(CreateRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RoomCreated) {
+        output.WriteRawTag(226, 1);
+        output.WriteMessage(RoomCreated);
+      }
+      if (msgCase_ == MsgOneofCase.JoinRoomByCodeRequest) {
+        output.WriteRawTag(234, 1);
+        output.WriteMessage(JoinRoomByCodeRequest);
+      }
+      if (msgCase_ == MsgOneofCase.KickPlayerRequest) {
+        output.WriteRawTag(242, 1);
+        output.WriteMessage(KickPlayerRequest);
+      }
+      if (msgCase_ == MsgOneofCase.LockRoomRequest) {
+        output.WriteRawTag(250, 1);
+        output.WriteMessage(LockRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CloseRoomRequest) {
+        output.WriteRawTag(130, 2);
+        output.WriteMessage(CloseRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RemovedFromRoom) {
+        output.WriteRawTag(138, 2);
+        output.WriteMessage(RemovedFromRoom);
+      }
+      if (msgCase_ == MsgOneofCase.GameOver) {
+        output.WriteRawTag(146, 2);
+        output.WriteMessage(GameOver);
+      }
+      if (msgCase_ == MsgOneofCase.TeamAssignment) {
+        output.WriteRawTag(154, 2);
+        output.WriteMessage(TeamAssignment);
+      }
+      if (msgCase_ == MsgOneofCase.TeamScoreboard) {
+        output.WriteRawTag(162, 2);
+        output.WriteMessage(TeamScoreboard);
+      }
+      if (msgCase_ == MsgOneofCase.RoundCountdown) {
+        output.WriteRawTag(170, 2);
+        output.WriteMessage(RoundCountdown);
+      }
+      if (msgCase_ == MsgOneofCase.RoundEnd) {
+        output.WriteRawTag(178, 2);
+        output.WriteMessage(RoundEnd);
+      }
+      if (msgCase_ == MsgOneofCase.Zone) {
+        output.WriteRawTag(186, 2);
+        output.WriteMessage(Zone);
+      }
+      if (msgCase_ == MsgOneofCase.WorldBounds) {
+        output.WriteRawTag(194, 2);
+        output.WriteMessage(WorldBounds);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerSplit) {
+        output.WriteRawTag(202, 2);
+        output.WriteMessage(PlayerSplit);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerEject) {
+        output.WriteRawTag(210, 2);
+        output.WriteMessage(PlayerEject);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerTarget) {
+        output.WriteRawTag(218, 2);
+        output.WriteMessage(PlayerTarget);
+      }
+      if (msgCase_ == MsgOneofCase.HazardBurst) {
+        output.WriteRawTag(226, 2);
+        output.WriteMessage(HazardBurst);
+      }
+      if (msgCase_ == MsgOneofCase.PowerUpCollected) {
+        output.WriteRawTag(234, 2);
+        output.WriteMessage(PowerUpCollected);
+      }
+      if (msgCase_ == MsgOneofCase.PowerUpExpired) {
+        output.WriteRawTag(242, 2);
+        output.WriteMessage(PowerUpExpired);
+      }
+      if (msgCase_ == MsgOneofCase.GameMap) {
+        output.WriteRawTag(250, 2);
+        output.WriteMessage(GameMap);
+      }
+      if (msgCase_ == MsgOneofCase.DeathSummary) {
+        output.WriteRawTag(130, 3);
+        output.WriteMessage(DeathSummary);
+      }
+      if (msgCase_ == MsgOneofCase.RespawnRequest) {
+        output.WriteRawTag(138, 3);
+        output.WriteMessage(RespawnRequest);
+      }
+      if (msgCase_ == MsgOneofCase.SpectateRoomRequest) {
+        output.WriteRawTag(146, 3);
+        output.WriteMessage(SpectateRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.FollowPlayerRequest) {
+        output.WriteRawTag(154, 3);
+        output.WriteMessage(FollowPlayerRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CycleSpectateTargetRequest) {
+        output.WriteRawTag(162, 3);
+        output.WriteMessage(CycleSpectateTargetRequest);
+      }
+      if (msgCase_ == MsgOneofCase.SpectateTarget) {
+        output.WriteRawTag(170, 3);
+        output.WriteMessage(SpectateTarget);
+      }
+      if (_unknownFields != null) {
+        _unknownFields.WriteTo(ref output);
+      }
+    }
+    #endif
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public int CalculateSize() {
+      int size = 0;
+      if (SenderId != 0UL) {
+        size += 1 + pb::CodedOutputStream.ComputeUInt64Size(SenderId);
+      }
+      if (msgCase_ == MsgOneofCase.Chat) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Chat);
+      }
+      if (msgCase_ == MsgOneofCase.Id) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Id);
+      }
+      if (msgCase_ == MsgOneofCase.LoginRequest) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(LoginRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RegisterRequest) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(RegisterRequest);
+      }
+      if (msgCase_ == MsgOneofCase.OkResponse) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(OkResponse);
+      }
+      if (msgCase_ == MsgOneofCase.DenyResponse) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(DenyResponse);
+      }
+      if (msgCase_ == MsgOneofCase.Player) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Player);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerDirection) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(PlayerDirection);
+      }
+      if (msgCase_ == MsgOneofCase.Spore) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Spore);
+      }
+      if (msgCase_ == MsgOneofCase.SporeConsumed) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(SporeConsumed);
+      }
+      if (msgCase_ == MsgOneofCase.SporeBatch) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(SporeBatch);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerConsumed) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(PlayerConsumed);
+      }
+      if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(HiscoreBoardRequest);
+      }
+      if (msgCase_ == MsgOneofCase.Hiscore) {
+        size += 1 + pb::CodedOutputStream.ComputeMessageSize(Hiscore);
+      }
+      if (msgCase_ == MsgOneofCase.HiscoreBoard) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(HiscoreBoard);
+      }
+      if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(FinishBrowsingHiscores);
+      }
+      if (msgCase_ == MsgOneofCase.SearchHiscore) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(SearchHiscore);
+      }
+      if (msgCase_ == MsgOneofCase.Disconnect) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(Disconnect);
+      }
+      if (msgCase_ == MsgOneofCase.ViewEnter) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(ViewEnter);
+      }
+      if (msgCase_ == MsgOneofCase.ViewLeave) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(ViewLeave);
+      }
+      if (msgCase_ == MsgOneofCase.Snapshot) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(Snapshot);
+      }
+      if (msgCase_ == MsgOneofCase.SnapshotAck) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(SnapshotAck);
+      }
+      if (msgCase_ == MsgOneofCase.RoomListRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(RoomListRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RoomList) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(RoomList);
+      }
+      if (msgCase_ == MsgOneofCase.JoinRoomRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(JoinRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CreateRoomRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(CreateRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RoomCreated) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(RoomCreated);
+      }
+      if (msgCase_ == MsgOneofCase.JoinRoomByCodeRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(JoinRoomByCodeRequest);
+      }
+      if (msgCase_ == MsgOneofCase.KickPlayerRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(KickPlayerRequest);
+      }
+      if (msgCase_ == MsgOneofCase.LockRoomRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(LockRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CloseRoomRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(CloseRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.RemovedFromRoom) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(RemovedFromRoom);
+      }
+      if (msgCase_ == MsgOneofCase.GameOver) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(GameOver);
+      }
+      if (msgCase_ == MsgOneofCase.TeamAssignment) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(TeamAssignment);
+      }
+      if (msgCase_ == MsgOneofCase.TeamScoreboard) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(TeamScoreboard);
+      }
+      if (msgCase_ == MsgOneofCase.RoundCountdown) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(RoundCountdown);
+      }
+      if (msgCase_ == MsgOneofCase.RoundEnd) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(RoundEnd);
+      }
+      if (msgCase_ == MsgOneofCase.Zone) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(Zone);
+      }
+      if (msgCase_ == MsgOneofCase.WorldBounds) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(WorldBounds);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerSplit) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(PlayerSplit);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerEject) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(PlayerEject);
+      }
+      if (msgCase_ == MsgOneofCase.PlayerTarget) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(PlayerTarget);
+      }
+      if (msgCase_ == MsgOneofCase.HazardBurst) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(HazardBurst);
+      }
+      if (msgCase_ == MsgOneofCase.PowerUpCollected) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(PowerUpCollected);
+      }
+      if (msgCase_ == MsgOneofCase.PowerUpExpired) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(PowerUpExpired);
+      }
+      if (msgCase_ == MsgOneofCase.GameMap) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(GameMap);
+      }
+      if (msgCase_ == MsgOneofCase.DeathSummary) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(DeathSummary);
+      }
+      if (msgCase_ == MsgOneofCase.RespawnRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(RespawnRequest);
+      }
+      if (msgCase_ == MsgOneofCase.SpectateRoomRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(SpectateRoomRequest);
+      }
+      if (msgCase_ == MsgOneofCase.FollowPlayerRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(FollowPlayerRequest);
+      }
+      if (msgCase_ == MsgOneofCase.CycleSpectateTargetRequest) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(CycleSpectateTargetRequest);
+      }
+      if (msgCase_ == MsgOneofCase.SpectateTarget) {
+        size += 2 + pb::CodedOutputStream.ComputeMessageSize(SpectateTarget);
+      }
+      if (_unknownFields != null) {
+        size += _unknownFields.CalculateSize();
+      }
+      return size;
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(Packet other) {
+      if (other == null) {
+        return;
+      }
+      if (other.SenderId != 0UL) {
+        SenderId = other.SenderId;
+      }
+      switch (other.MsgCase) {
+        case MsgOneofCase.Chat:
+          if (Chat == null) {
+            Chat = new global::Client.Packets.ChatMessage();
+          }
+          Chat.MergeFrom(other.Chat);
+          break;
+        case MsgOneofCase.Id:
+          if (Id == null) {
+            Id = new global::Client.Packets.IdMessage();
+          }
+          Id.MergeFrom(other.Id);
+          break;
+        case MsgOneofCase.LoginRequest:
+          if (LoginRequest == null) {
+            LoginRequest = new global::Client.Packets.LoginRequestMessage();
+          }
+          LoginRequest.MergeFrom(other.LoginRequest);
+          break;
+        case MsgOneofCase.RegisterRequest:
+          if (RegisterRequest == null) {
+            RegisterRequest = new global::Client.Packets.RegisterRequestMessage();
+          }
+          RegisterRequest.MergeFrom(other.RegisterRequest);
+          break;
+        case MsgOneofCase.OkResponse:
+          if (OkResponse == null) {
+            OkResponse = new global::Client.Packets.OkResponseMessage();
+          }
+          OkResponse.MergeFrom(other.OkResponse);
+          break;
+        case MsgOneofCase.DenyResponse:
+          if (DenyResponse == null) {
+            DenyResponse = new global::Client.Packets.DenyResponseMessage();
+          }
+          DenyResponse.MergeFrom(other.DenyResponse);
+          break;
+        case MsgOneofCase.Player:
+          if (Player == null) {
+            Player = new global::Client.Packets.PlayerMessage();
+          }
+          Player.MergeFrom(other.Player);
+          break;
+        case MsgOneofCase.PlayerDirection:
+          if (PlayerDirection == null) {
+            PlayerDirection = new global::Client.Packets.PlayerDirectionMessage();
+          }
+          PlayerDirection.MergeFrom(other.PlayerDirection);
+          break;
+        case MsgOneofCase.Spore:
+          if (Spore == null) {
+            Spore = new global::Client.Packets.SporeMessage();
+          }
+          Spore.MergeFrom(other.Spore);
+          break;
+        case MsgOneofCase.SporeConsumed:
+          if (SporeConsumed == null) {
+            SporeConsumed = new global::Client.Packets.SporeConsumedMessage();
+          }
+          SporeConsumed.MergeFrom(other.SporeConsumed);
+          break;
+        case MsgOneofCase.SporeBatch:
+          if (SporeBatch == null) {
+            SporeBatch = new global::Client.Packets.SporeBatchMessage();
+          }
+          SporeBatch.MergeFrom(other.SporeBatch);
+          break;
+        case MsgOneofCase.PlayerConsumed:
+          if (PlayerConsumed == null) {
+            PlayerConsumed = new global::Client.Packets.PlayerConsumedMessage();
+          }
+          PlayerConsumed.MergeFrom(other.PlayerConsumed);
+          break;
+        case MsgOneofCase.HiscoreBoardRequest:
+          if (HiscoreBoardRequest == null) {
+            HiscoreBoardRequest = new global::Client.Packets.HiscoreBoardRequestMessage();
+          }
+          HiscoreBoardRequest.MergeFrom(other.HiscoreBoardRequest);
+          break;
+        case MsgOneofCase.Hiscore:
+          if (Hiscore == null) {
+            Hiscore = new global::Client.Packets.HiscoreMessage();
+          }
+          Hiscore.MergeFrom(other.Hiscore);
+          break;
+        case MsgOneofCase.HiscoreBoard:
+          if (HiscoreBoard == null) {
+            HiscoreBoard = new global::Client.Packets.HiscoreBoardMessage();
+          }
+          HiscoreBoard.MergeFrom(other.HiscoreBoard);
+          break;
+        case MsgOneofCase.FinishBrowsingHiscores:
+          if (FinishBrowsingHiscores == null) {
+            FinishBrowsingHiscores = new global::Client.Packets.FinishedBrowsingHiscoresMessage();
+          }
+          FinishBrowsingHiscores.MergeFrom(other.FinishBrowsingHiscores);
+          break;
+        case MsgOneofCase.SearchHiscore:
+          if (SearchHiscore == null) {
+            SearchHiscore = new global::Client.Packets.SearchHiscoreMessage();
+          }
+          SearchHiscore.MergeFrom(other.SearchHiscore);
+          break;
+        case MsgOneofCase.Disconnect:
+          if (Disconnect == null) {
+            Disconnect = new global::Client.Packets.DisconnectMessage();
+          }
+          Disconnect.MergeFrom(other.Disconnect);
+          break;
+        case MsgOneofCase.ViewEnter:
+          if (ViewEnter == null) {
+            ViewEnter = new global::Client.Packets.ViewEnterMessage();
+          }
+          ViewEnter.MergeFrom(other.ViewEnter);
+          break;
+        case MsgOneofCase.ViewLeave:
+          if (ViewLeave == null) {
+            ViewLeave = new global::Client.Packets.ViewLeaveMessage();
+          }
+          ViewLeave.MergeFrom(other.ViewLeave);
+          break;
+        case MsgOneofCase.Snapshot:
+          if (Snapshot == null) {
+            Snapshot = new global::Client.Packets.SnapshotMessage();
+          }
+          Snapshot.MergeFrom(other.Snapshot);
+          break;
+        case MsgOneofCase.SnapshotAck:
+          if (SnapshotAck == null) {
+            SnapshotAck = new global::Client.Packets.SnapshotAckMessage();
+          }
+          SnapshotAck.MergeFrom(other.SnapshotAck);
+          break;
+        case MsgOneofCase.RoomListRequest:
+          if (RoomListRequest == null) {
+            RoomListRequest = new global::Client.Packets.RoomListRequestMessage();
+          }
+          RoomListRequest.MergeFrom(other.RoomListRequest);
+          break;
+        case MsgOneofCase.RoomList:
+          if (RoomList == null) {
+            RoomList = new global::Client.Packets.RoomListMessage();
+          }
+          RoomList.MergeFrom(other.RoomList);
+          break;
+        case MsgOneofCase.JoinRoomRequest:
+          if (JoinRoomRequest == null) {
+            JoinRoomRequest = new global::Client.Packets.JoinRoomRequestMessage();
+          }
+          JoinRoomRequest.MergeFrom(other.JoinRoomRequest);
+          break;
+        case MsgOneofCase.CreateRoomRequest:
+          if (CreateRoomRequest == null) {
+            CreateRoomRequest = new global::Client.Packets.CreateRoomRequestMessage();
+          }
+          CreateRoomRequest.MergeFrom(other.CreateRoomRequest);
+          break;
+        case MsgOneofCase.RoomCreated:
+          if (RoomCreated == null) {
+            RoomCreated = new global::Client.Packets.RoomCreatedMessage();
+          }
+          RoomCreated.MergeFrom(other.RoomCreated);
+          break;
+        case MsgOneofCase.JoinRoomByCodeRequest:
+          if (JoinRoomByCodeRequest == null) {
+            JoinRoomByCodeRequest = new global::Client.Packets.JoinRoomByCodeRequestMessage();
+          }
+          JoinRoomByCodeRequest.MergeFrom(other.JoinRoomByCodeRequest);
+          break;
+        case MsgOneofCase.KickPlayerRequest:
+          if (KickPlayerRequest == null) {
+            KickPlayerRequest = new global::Client.Packets.KickPlayerRequestMessage();
+          }
+          KickPlayerRequest.MergeFrom(other.KickPlayerRequest);
+          break;
+        case MsgOneofCase.LockRoomRequest:
+          if (LockRoomRequest == null) {
+            LockRoomRequest = new global::Client.Packets.LockRoomRequestMessage();
+          }
+          LockRoomRequest.MergeFrom(other.LockRoomRequest);
+          break;
+        case MsgOneofCase.CloseRoomRequest:
+          if (CloseRoomRequest == null) {
+            CloseRoomRequest = new global::Client.Packets.CloseRoomRequestMessage();
+          }
+          CloseRoomRequest.MergeFrom(other.CloseRoomRequest);
+          break;
+        case MsgOneofCase.RemovedFromRoom:
+          if (RemovedFromRoom == null) {
+            RemovedFromRoom = new global::Client.Packets.RemovedFromRoomMessage();
+          }
+          RemovedFromRoom.MergeFrom(other.RemovedFromRoom);
+          break;
+        case MsgOneofCase.GameOver:
+          if (GameOver == null) {
+            GameOver = new global::Client.Packets.GameOverMessage();
+          }
+          GameOver.MergeFrom(other.GameOver);
+          break;
+        case MsgOneofCase.TeamAssignment:
+          if (TeamAssignment == null) {
+            TeamAssignment = new global::Client.Packets.TeamAssignmentMessage();
+          }
+          TeamAssignment.MergeFrom(other.TeamAssignment);
+          break;
+        case MsgOneofCase.TeamScoreboard:
+          if (TeamScoreboard == null) {
+            TeamScoreboard = new global::Client.Packets.TeamScoreboardMessage();
+          }
+          TeamScoreboard.MergeFrom(other.TeamScoreboard);
+          break;
+        case MsgOneofCase.RoundCountdown:
+          if (RoundCountdown == null) {
+            RoundCountdown = new global::Client.Packets.RoundCountdownMessage();
+          }
+          RoundCountdown.MergeFrom(other.RoundCountdown);
+          break;
+        case MsgOneofCase.RoundEnd:
+          if (RoundEnd == null) {
+            RoundEnd = new global::Client.Packets.RoundEndMessage();
+          }
+          RoundEnd.MergeFrom(other.RoundEnd);
+          break;
+        case MsgOneofCase.Zone:
+          if (Zone == null) {
+            Zone = new global::Client.Packets.ZoneMessage();
+          }
+          Zone.MergeFrom(other.Zone);
+          break;
+        case MsgOneofCase.WorldBounds:
+          if (WorldBounds == null) {
+            WorldBounds = new global::Client.Packets.WorldBoundsMessage();
+          }
+          WorldBounds.MergeFrom(other.WorldBounds);
+          break;
+        case MsgOneofCase.PlayerSplit:
+          if (PlayerSplit == null) {
+            PlayerSplit = new global::Client.Packets.PlayerSplitMessage();
+          }
+          PlayerSplit.MergeFrom(other.PlayerSplit);
+          break;
+        case MsgOneofCase.PlayerEject:
+          if (PlayerEject == null) {
+            PlayerEject = new global::Client.Packets.PlayerEjectMessage();
+          }
+          PlayerEject.MergeFrom(other.PlayerEject);
+          break;
+        case MsgOneofCase.PlayerTarget:
+          if (PlayerTarget == null) {
+            PlayerTarget = new global::Client.Packets.PlayerTargetMessage();
+          }
+          PlayerTarget.MergeFrom(other.PlayerTarget);
+          break;
+        case MsgOneofCase.HazardBurst:
+          if (HazardBurst == null) {
+            HazardBurst = new global::Client.Packets.HazardBurstMessage();
+          }
+          HazardBurst.MergeFrom(other.HazardBurst);
+          break;
+        case MsgOneofCase.PowerUpCollected:
+          if (PowerUpCollected == null) {
+            PowerUpCollected = new global::Client.Packets.PowerUpCollectedMessage();
+          }
+          PowerUpCollected.MergeFrom(other.PowerUpCollected);
+          break;
+        case MsgOneofCase.PowerUpExpired:
+          if (PowerUpExpired == null) {
+            PowerUpExpired = new global::Client.Packets.PowerUpExpiredMessage();
+          }
+          PowerUpExpired.MergeFrom(other.PowerUpExpired);
+          break;
+        case MsgOneofCase.GameMap:
+          if (GameMap == null) {
+            GameMap = new global::Client.Packets.GameMapMessage();
+          }
+          GameMap.MergeFrom(other.GameMap);
+          break;
+        case MsgOneofCase.DeathSummary:
+          if (DeathSummary == null) {
+            DeathSummary = new global::Client.Packets.DeathSummaryMessage();
+          }
+          DeathSummary.MergeFrom(other.DeathSummary);
+          break;
+        case MsgOneofCase.RespawnRequest:
+          if (RespawnRequest == null) {
+            RespawnRequest = new global::Client.Packets.RespawnRequestMessage();
+          }
+          RespawnRequest.MergeFrom(other.RespawnRequest);
+          break;
+        case MsgOneofCase.SpectateRoomRequest:
+          if (SpectateRoomRequest == null) {
+            SpectateRoomRequest = new global::Client.Packets.SpectateRoomRequestMessage();
+          }
+          SpectateRoomRequest.MergeFrom(other.SpectateRoomRequest);
+          break;
+        case MsgOneofCase.FollowPlayerRequest:
+          if (FollowPlayerRequest == null) {
+            FollowPlayerRequest = new global::Client.Packets.FollowPlayerRequestMessage();
+          }
+          FollowPlayerRequest.MergeFrom(other.FollowPlayerRequest);
+          break;
+        case MsgOneofCase.CycleSpectateTargetRequest:
+          if (CycleSpectateTargetRequest == null) {
+            CycleSpectateTargetRequest = new global::Client.Packets.CycleSpectateTargetRequestMessage();
+          }
+          CycleSpectateTargetRequest.MergeFrom(other.CycleSpectateTargetRequest);
+          break;
+        case MsgOneofCase.SpectateTarget:
+          if (SpectateTarget == null) {
+            SpectateTarget = new global::Client.Packets.SpectateTargetMessage();
+          }
+          SpectateTarget.MergeFrom(other.SpectateTarget);
+          break;
+      }
+
+      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    }
+
+    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
+    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
+    public void MergeFrom(pb::CodedInputStream input) {
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
+      input.ReadRawMessage(this);
+    #else
+      uint tag;
+      while ((tag = input.ReadTag()) != 0) {
+      if ((tag & 7) == 4) {
+        // Abort on any end group tag.
+        return;
+      }
+      switch(tag) {
+          default:
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            break;
+          case 8: {
+            SenderId = input.ReadUInt64();
+            break;
+          }
+          case 18: {
+            global::Client.Packets.ChatMessage subBuilder = new global::Client.Packets.ChatMessage();
+            if (msgCase_ == MsgOneofCase.Chat) {
+              subBuilder.MergeFrom(Chat);
+            }
+            input.ReadMessage(subBuilder);
+            Chat = subBuilder;
+            break;
+          }
+          case 26: {
+            global::Client.Packets.IdMessage subBuilder = new global::Client.Packets.IdMessage();
+            if (msgCase_ == MsgOneofCase.Id) {
+              subBuilder.MergeFrom(Id);
+            }
+            input.ReadMessage(subBuilder);
+            Id = subBuilder;
+            break;
+          }
+          case 34: {
+            global::Client.Packets.LoginRequestMessage subBuilder = new global::Client.Packets.LoginRequestMessage();
+            if (msgCase_ == MsgOneofCase.LoginRequest) {
+              subBuilder.MergeFrom(LoginRequest);
+            }
+            input.ReadMessage(subBuilder);
+            LoginRequest = subBuilder;
+            break;
+          }
+          case 42: {
+            global::Client.Packets.RegisterRequestMessage subBuilder = new global::Client.Packets.RegisterRequestMessage();
+            if (msgCase_ == MsgOneofCase.RegisterRequest) {
+              subBuilder.MergeFrom(RegisterRequest);
+            }
+            input.ReadMessage(subBuilder);
+            RegisterRequest = subBuilder;
+            break;
+          }
+          case 50: {
+            global::Client.Packets.OkResponseMessage subBuilder = new global::Client.Packets.OkResponseMessage();
+            if (msgCase_ == MsgOneofCase.OkResponse) {
+              subBuilder.MergeFrom(OkResponse);
+            }
+            input.ReadMessage(subBuilder);
+            OkResponse = subBuilder;
+            break;
+          }
+          case 58: {
+            global::Client.Packets.DenyResponseMessage subBuilder = new global::Client.Packets.DenyResponseMessage();
+            if (msgCase_ == MsgOneofCase.DenyResponse) {
+              subBuilder.MergeFrom(DenyResponse);
+            }
+            input.ReadMessage(subBuilder);
+            DenyResponse = subBuilder;
+            break;
+          }
+          case 66: {
+            global::Client.Packets.PlayerMessage subBuilder = new global::Client.Packets.PlayerMessage();
+            if (msgCase_ == MsgOneofCase.Player) {
+              subBuilder.MergeFrom(Player);
+            }
+            input.ReadMessage(subBuilder);
+            Player = subBuilder;
+            break;
+          }
+          case 74: {
+            global::Client.Packets.PlayerDirectionMessage subBuilder = new global::Client.Packets.PlayerDirectionMessage();
+            if (msgCase_ == MsgOneofCase.PlayerDirection) {
+              subBuilder.MergeFrom(PlayerDirection);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerDirection = subBuilder;
+            break;
+          }
+          case 82: {
+            global::Client.Packets.SporeMessage subBuilder = new global::Client.Packets.SporeMessage();
+            if (msgCase_ == MsgOneofCase.Spore) {
+              subBuilder.MergeFrom(Spore);
+            }
+            input.ReadMessage(subBuilder);
+            Spore = subBuilder;
+            break;
+          }
+          case 90: {
+            global::Client.Packets.SporeConsumedMessage subBuilder = new global::Client.Packets.SporeConsumedMessage();
+            if (msgCase_ == MsgOneofCase.SporeConsumed) {
+              subBuilder.MergeFrom(SporeConsumed);
+            }
+            input.ReadMessage(subBuilder);
+            SporeConsumed = subBuilder;
+            break;
+          }
+          case 98: {
+            global::Client.Packets.SporeBatchMessage subBuilder = new global::Client.Packets.SporeBatchMessage();
+            if (msgCase_ == MsgOneofCase.SporeBatch) {
+              subBuilder.MergeFrom(SporeBatch);
+            }
+            input.ReadMessage(subBuilder);
+            SporeBatch = subBuilder;
+            break;
+          }
+          case 106: {
+            global::Client.Packets.PlayerConsumedMessage subBuilder = new global::Client.Packets.PlayerConsumedMessage();
+            if (msgCase_ == MsgOneofCase.PlayerConsumed) {
+              subBuilder.MergeFrom(PlayerConsumed);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerConsumed = subBuilder;
+            break;
+          }
+          case 114: {
+            global::Client.Packets.HiscoreBoardRequestMessage subBuilder = new global::Client.Packets.HiscoreBoardRequestMessage();
+            if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
+              subBuilder.MergeFrom(HiscoreBoardRequest);
+            }
+            input.ReadMessage(subBuilder);
+            HiscoreBoardRequest = subBuilder;
+            break;
+          }
+          case 122: {
+            global::Client.Packets.HiscoreMessage subBuilder = new global::Client.Packets.HiscoreMessage();
+            if (msgCase_ == MsgOneofCase.Hiscore) {
+              subBuilder.MergeFrom(Hiscore);
+            }
+            input.ReadMessage(subBuilder);
+            Hiscore = subBuilder;
+            break;
+          }
+          case 130: {
+            global::Client.Packets.HiscoreBoardMessage subBuilder = new global::Client.Packets.HiscoreBoardMessage();
+            if (msgCase_ == MsgOneofCase.HiscoreBoard) {
+              subBuilder.MergeFrom(HiscoreBoard);
+            }
+            input.ReadMessage(subBuilder);
+            HiscoreBoard = subBuilder;
+            break;
+          }
+          case 138: {
+            global::Client.Packets.FinishedBrowsingHiscoresMessage subBuilder = new global::Client.Packets.FinishedBrowsingHiscoresMessage();
+            if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
+              subBuilder.MergeFrom(FinishBrowsingHiscores);
+            }
+            input.ReadMessage(subBuilder);
+            FinishBrowsingHiscores = subBuilder;
+            break;
+          }
+          case 146: {
+            global::Client.Packets.SearchHiscoreMessage subBuilder = new global::Client.Packets.SearchHiscoreMessage();
+            if (msgCase_ == MsgOneofCase.SearchHiscore) {
+              subBuilder.MergeFrom(SearchHiscore);
+            }
+            input.ReadMessage(subBuilder);
+            SearchHiscore = subBuilder;
+            break;
+          }
+          case 154: {
+            global::Client.Packets.DisconnectMessage subBuilder = new global::Client.Packets.DisconnectMessage();
+            if (msgCase_ == MsgOneofCase.Disconnect) {
+              subBuilder.MergeFrom(Disconnect);
+            }
+            input.ReadMessage(subBuilder);
+            Disconnect = subBuilder;
+            break;
+          }
+          case 162: {
+            global::Client.Packets.ViewEnterMessage subBuilder = new global::Client.Packets.ViewEnterMessage();
+            if (msgCase_ == MsgOneofCase.ViewEnter) {
+              subBuilder.MergeFrom(ViewEnter);
+            }
+            input.ReadMessage(subBuilder);
+            ViewEnter = subBuilder;
+            break;
+          }
+          case 170: {
+            global::Client.Packets.ViewLeaveMessage subBuilder = new global::Client.Packets.ViewLeaveMessage();
+            if (msgCase_ == MsgOneofCase.ViewLeave) {
+              subBuilder.MergeFrom(ViewLeave);
+            }
+            input.ReadMessage(subBuilder);
+            ViewLeave = subBuilder;
+            break;
+          }
+          case 178: {
+            global::Client.Packets.SnapshotMessage subBuilder = new global::Client.Packets.SnapshotMessage();
+            if (msgCase_ == MsgOneofCase.Snapshot) {
+              subBuilder.MergeFrom(Snapshot);
+            }
+            input.ReadMessage(subBuilder);
+            Snapshot = subBuilder;
+            break;
+          }
+          case 186: {
+            global::Client.Packets.SnapshotAckMessage subBuilder = new global::Client.Packets.SnapshotAckMessage();
+            if (msgCase_ == MsgOneofCase.SnapshotAck) {
+              subBuilder.MergeFrom(SnapshotAck);
+            }
+            input.ReadMessage(subBuilder);
+            SnapshotAck = subBuilder;
+            break;
+          }
+          case 194: {
+            global::Client.Packets.RoomListRequestMessage subBuilder = new global::Client.Packets.RoomListRequestMessage();
+            if (msgCase_ == MsgOneofCase.RoomListRequest) {
+              subBuilder.MergeFrom(RoomListRequest);
+            }
+            input.ReadMessage(subBuilder);
+            RoomListRequest = subBuilder;
+            break;
+          }
+          case 202: {
+            global::Client.Packets.RoomListMessage subBuilder = new global::Client.Packets.RoomListMessage();
+            if (msgCase_ == MsgOneofCase.RoomList) {
+              subBuilder.MergeFrom(RoomList);
+            }
+            input.ReadMessage(subBuilder);
+            RoomList = subBuilder;
+            break;
+          }
+          case 210: {
+            global::Client.Packets.JoinRoomRequestMessage subBuilder = new global::Client.Packets.JoinRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.JoinRoomRequest) {
+              subBuilder.MergeFrom(JoinRoomRequest);
+            }
+            input.ReadMessage(subBuilder);
+            JoinRoomRequest = subBuilder;
+            break;
+          }
+          case 218: {
+            global::Client.Packets.CreateRoomRequestMessage subBuilder = new global::Client.Packets.CreateRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.CreateRoomRequest) {
+              subBuilder.MergeFrom(CreateRoomRequest);
+            }
+            input.ReadMessage(subBuilder);
+            CreateRoomRequest = subBuilder;
+            break;
+          }
+          case 226: {
+            global::Client.Packets.RoomCreatedMessage subBuilder = new global::Client.Packets.RoomCreatedMessage();
+            if (msgCase_ == MsgOneofCase.RoomCreated) {
+              subBuilder.MergeFrom(RoomCreated);
+            }
+            input.ReadMessage(subBuilder);
+            RoomCreated = subBuilder;
+            break;
+          }
+          case 234: {
+            global::Client.Packets.JoinRoomByCodeRequestMessage subBuilder = new global::Client.Packets.JoinRoomByCodeRequestMessage();
+            if (msgCase_ == MsgOneofCase.JoinRoomByCodeRequest) {
+              subBuilder.MergeFrom(JoinRoomByCodeRequest);
+            }
+            input.ReadMessage(subBuilder);
+            JoinRoomByCodeRequest = subBuilder;
+            break;
+          }
+          case 242: {
+            global::Client.Packets.KickPlayerRequestMessage subBuilder = new global::Client.Packets.KickPlayerRequestMessage();
+            if (msgCase_ == MsgOneofCase.KickPlayerRequest) {
+              subBuilder.MergeFrom(KickPlayerRequest);
+            }
+            input.ReadMessage(subBuilder);
+            KickPlayerRequest = subBuilder;
+            break;
+          }
+          case 250: {
+            global::Client.Packets.LockRoomRequestMessage subBuilder = new global::Client.Packets.LockRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.LockRoomRequest) {
+              subBuilder.MergeFrom(LockRoomRequest);
+            }
+            input.ReadMessage(subBuilder);
+            LockRoomRequest = subBuilder;
+            break;
+          }
+          case 258: {
+            global::Client.Packets.CloseRoomRequestMessage subBuilder = new global::Client.Packets.CloseRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.CloseRoomRequest) {
+              subBuilder.MergeFrom(CloseRoomRequest);
+            }
+            input.ReadMessage(subBuilder);
+            CloseRoomRequest = subBuilder;
+            break;
+          }
+          case 266: {
+            global::Client.Packets.RemovedFromRoomMessage subBuilder = new global::Client.Packets.RemovedFromRoomMessage();
+            if (msgCase_ == MsgOneofCase.RemovedFromRoom) {
+              subBuilder.MergeFrom(RemovedFromRoom);
+            }
+            input.ReadMessage(subBuilder);
+            RemovedFromRoom = subBuilder;
+            break;
+          }
+          case 274: {
+            global::Client.Packets.GameOverMessage subBuilder = new global::Client.Packets.GameOverMessage();
+            if (msgCase_ == MsgOneofCase.GameOver) {
+              subBuilder.MergeFrom(GameOver);
+            }
+            input.ReadMessage(subBuilder);
+            GameOver = subBuilder;
+            break;
+          }
+          case 282: {
+            global::Client.Packets.TeamAssignmentMessage subBuilder = new global::Client.Packets.TeamAssignmentMessage();
+            if (msgCase_ == MsgOneofCase.TeamAssignment) {
+              subBuilder.MergeFrom(TeamAssignment);
+            }
+            input.ReadMessage(subBuilder);
+            TeamAssignment = subBuilder;
+            break;
+          }
+          case 290: {
+            global::Client.Packets.TeamScoreboardMessage subBuilder = new global::Client.Packets.TeamScoreboardMessage();
+            if (msgCase_ == MsgOneofCase.TeamScoreboard) {
+              subBuilder.MergeFrom(TeamScoreboard);
+            }
+            input.ReadMessage(subBuilder);
+            TeamScoreboard = subBuilder;
+            break;
+          }
+          case 298: {
+            global::Client.Packets.RoundCountdownMessage subBuilder = new global::Client.Packets.RoundCountdownMessage();
+            if (msgCase_ == MsgOneofCase.RoundCountdown) {
+              subBuilder.MergeFrom(RoundCountdown);
+            }
+            input.ReadMessage(subBuilder);
+            RoundCountdown = subBuilder;
+            break;
+          }
+          case 306: {
+            global::Client.Packets.RoundEndMessage subBuilder = new global::Client.Packets.RoundEndMessage();
+            if (msgCase_ == MsgOneofCase.RoundEnd) {
+              subBuilder.MergeFrom(RoundEnd);
+            }
+            input.ReadMessage(subBuilder);
+            RoundEnd = subBuilder;
+            break;
+          }
+          case 314: {
+            global::Client.Packets.ZoneMessage subBuilder = new global::Client.Packets.ZoneMessage();
+            if (msgCase_ == MsgOneofCase.Zone) {
+              subBuilder.MergeFrom(Zone);
+            }
+            input.ReadMessage(subBuilder);
+            Zone = subBuilder;
+            break;
+          }
+          case 322: {
+            global::Client.Packets.WorldBoundsMessage subBuilder = new global::Client.Packets.WorldBoundsMessage();
+            if (msgCase_ == MsgOneofCase.WorldBounds) {
+              subBuilder.MergeFrom(WorldBounds);
+            }
+            input.ReadMessage(subBuilder);
+            WorldBounds = subBuilder;
+            break;
+          }
+          case 330: {
+            global::Client.Packets.PlayerSplitMessage subBuilder = new global::Client.Packets.PlayerSplitMessage();
+            if (msgCase_ == MsgOneofCase.PlayerSplit) {
+              subBuilder.MergeFrom(PlayerSplit);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerSplit = subBuilder;
+            break;
+          }
+          case 338: {
+            global::Client.Packets.PlayerEjectMessage subBuilder = new global::Client.Packets.PlayerEjectMessage();
+            if (msgCase_ == MsgOneofCase.PlayerEject) {
+              subBuilder.MergeFrom(PlayerEject);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerEject = subBuilder;
+            break;
+          }
+          case 346: {
+            global::Client.Packets.PlayerTargetMessage subBuilder = new global::Client.Packets.PlayerTargetMessage();
+            if (msgCase_ == MsgOneofCase.PlayerTarget) {
+              subBuilder.MergeFrom(PlayerTarget);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerTarget = subBuilder;
+            break;
+          }
+          case 354: {
+            global::Client.Packets.HazardBurstMessage subBuilder = new global::Client.Packets.HazardBurstMessage();
+            if (msgCase_ == MsgOneofCase.HazardBurst) {
+              subBuilder.MergeFrom(HazardBurst);
+            }
+            input.ReadMessage(subBuilder);
+            HazardBurst = subBuilder;
+            break;
           }
-          SporeConsumed.MergeFrom(other.SporeConsumed);
-          break;
-        case MsgOneofCase.SporeBatch:
-          if (SporeBatch == null) {
-            SporeBatch = new global::Client.Packets.SporeBatchMessage();
+          case 362: {
+            global::Client.Packets.PowerUpCollectedMessage subBuilder = new global::Client.Packets.PowerUpCollectedMessage();
+            if (msgCase_ == MsgOneofCase.PowerUpCollected) {
+              subBuilder.MergeFrom(PowerUpCollected);
+            }
+            input.ReadMessage(subBuilder);
+            PowerUpCollected = subBuilder;
+            break;
           }
-          SporeBatch.MergeFrom(other.SporeBatch);
-          break;
-        case MsgOneofCase.PlayerConsumed:
-          if (PlayerConsumed == null) {
-            PlayerConsumed = new global::Client.Packets.PlayerConsumedMessage();
+          case 370: {
+            global::Client.Packets.PowerUpExpiredMessage subBuilder = new global::Client.Packets.PowerUpExpiredMessage();
+            if (msgCase_ == MsgOneofCase.PowerUpExpired) {
+              subBuilder.MergeFrom(PowerUpExpired);
+            }
+            input.ReadMessage(subBuilder);
+            PowerUpExpired = subBuilder;
+            break;
           }
-          PlayerConsumed.MergeFrom(other.PlayerConsumed);
-          break;
-        case MsgOneofCase.HiscoreBoardRequest:
-          if (HiscoreBoardRequest == null) {
-            HiscoreBoardRequest = new global::Client.Packets.HiscoreBoardRequestMessage();
+          case 378: {
+            global::Client.Packets.GameMapMessage subBuilder = new global::Client.Packets.GameMapMessage();
+            if (msgCase_ == MsgOneofCase.GameMap) {
+              subBuilder.MergeFrom(GameMap);
+            }
+            input.ReadMessage(subBuilder);
+            GameMap = subBuilder;
+            break;
           }
-          HiscoreBoardRequest.MergeFrom(other.HiscoreBoardRequest);
-          break;
-        case MsgOneofCase.Hiscore:
-          if (Hiscore == null) {
-            Hiscore = new global::Client.Packets.HiscoreMessage();
+          case 386: {
+            global::Client.Packets.DeathSummaryMessage subBuilder = new global::Client.Packets.DeathSummaryMessage();
+            if (msgCase_ == MsgOneofCase.DeathSummary) {
+              subBuilder.MergeFrom(DeathSummary);
+            }
+            input.ReadMessage(subBuilder);
+            DeathSummary = subBuilder;
+            break;
           }
-          Hiscore.MergeFrom(other.Hiscore);
-          break;
-        case MsgOneofCase.HiscoreBoard:
-          if (HiscoreBoard == null) {
-            HiscoreBoard = new global::Client.Packets.HiscoreBoardMessage();
+          case 394: {
+            global::Client.Packets.RespawnRequestMessage subBuilder = new global::Client.Packets.RespawnRequestMessage();
+            if (msgCase_ == MsgOneofCase.RespawnRequest) {
+              subBuilder.MergeFrom(RespawnRequest);
+            }
+            input.ReadMessage(subBuilder);
+            RespawnRequest = subBuilder;
+            break;
           }
-          HiscoreBoard.MergeFrom(other.HiscoreBoard);
-          break;
-        case MsgOneofCase.FinishBrowsingHiscores:
-          if (FinishBrowsingHiscores == null) {
-            FinishBrowsingHiscores = new global::Client.Packets.FinishedBrowsingHiscoresMessage();
+          case 402: {
+            global::Client.Packets.SpectateRoomRequestMessage subBuilder = new global::Client.Packets.SpectateRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.SpectateRoomRequest) {
+              subBuilder.MergeFrom(SpectateRoomRequest);
+            }
+            input.ReadMessage(subBuilder);
+            SpectateRoomRequest = subBuilder;
+            break;
           }
-          FinishBrowsingHiscores.MergeFrom(other.FinishBrowsingHiscores);
-          break;
-        case MsgOneofCase.SearchHiscore:
-          if (SearchHiscore == null) {
-            SearchHiscore = new global::Client.Packets.SearchHiscoreMessage();
+          case 410: {
+            global::Client.Packets.FollowPlayerRequestMessage subBuilder = new global::Client.Packets.FollowPlayerRequestMessage();
+            if (msgCase_ == MsgOneofCase.FollowPlayerRequest) {
+              subBuilder.MergeFrom(FollowPlayerRequest);
+            }
+            input.ReadMessage(subBuilder);
+            FollowPlayerRequest = subBuilder;
+            break;
           }
-          SearchHiscore.MergeFrom(other.SearchHiscore);
-          break;
-        case MsgOneofCase.Disconnect:
-          if (Disconnect == null) {
-            Disconnect = new global::Client.Packets.DisconnectMessage();
+          case 418: {
+            global::Client.Packets.CycleSpectateTargetRequestMessage subBuilder = new global::Client.Packets.CycleSpectateTargetRequestMessage();
+            if (msgCase_ == MsgOneofCase.CycleSpectateTargetRequest) {
+              subBuilder.MergeFrom(CycleSpectateTargetRequest);
+            }
+            input.ReadMessage(subBuilder);
+            CycleSpectateTargetRequest = subBuilder;
+            break;
           }
-          Disconnect.MergeFrom(other.Disconnect);
-          break;
+          case 426: {
+            global::Client.Packets.SpectateTargetMessage subBuilder = new global::Client.Packets.SpectateTargetMessage();
+            if (msgCase_ == MsgOneofCase.SpectateTarget) {
+              subBuilder.MergeFrom(SpectateTarget);
+            }
+            input.ReadMessage(subBuilder);
+            SpectateTarget = subBuilder;
+            break;
+          }
+        }
       }
-
-      _unknownFields = pb::UnknownFieldSet.MergeFrom(_unknownFields, other._unknownFields);
+    #endif
     }
 
+    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
     [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
     [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    public void MergeFrom(pb::CodedInputStream input) {
-    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
-      input.ReadRawMessage(this);
-    #else
+    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
       uint tag;
       while ((tag = input.ReadTag()) != 0) {
       if ((tag & 7) == 4) {
@@ -4901,7 +17338,7 @@ namespace Client.Packets {
       }
       switch(tag) {
           default:
-            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, input);
+            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
             break;
           case 8: {
             SenderId = input.ReadUInt64();
@@ -5069,189 +17506,310 @@ namespace Client.Packets {
             Disconnect = subBuilder;
             break;
           }
-        }
-      }
-    #endif
-    }
-
-    #if !GOOGLE_PROTOBUF_REFSTRUCT_COMPATIBILITY_MODE
-    [global::System.Diagnostics.DebuggerNonUserCodeAttribute]
-    [global::System.CodeDom.Compiler.GeneratedCode("protoc", null)]
-    void pb::IBufferMessage.InternalMergeFrom(ref pb::ParseContext input) {
-      uint tag;
-      while ((tag = input.ReadTag()) != 0) {
-      if ((tag & 7) == 4) {
-        // Abort on any end group tag.
-        return;
-      }
-      switch(tag) {
-          default:
-            _unknownFields = pb::UnknownFieldSet.MergeFieldFrom(_unknownFields, ref input);
+          case 162: {
+            global::Client.Packets.ViewEnterMessage subBuilder = new global::Client.Packets.ViewEnterMessage();
+            if (msgCase_ == MsgOneofCase.ViewEnter) {
+              subBuilder.MergeFrom(ViewEnter);
+            }
+            input.ReadMessage(subBuilder);
+            ViewEnter = subBuilder;
             break;
-          case 8: {
-            SenderId = input.ReadUInt64();
+          }
+          case 170: {
+            global::Client.Packets.ViewLeaveMessage subBuilder = new global::Client.Packets.ViewLeaveMessage();
+            if (msgCase_ == MsgOneofCase.ViewLeave) {
+              subBuilder.MergeFrom(ViewLeave);
+            }
+            input.ReadMessage(subBuilder);
+            ViewLeave = subBuilder;
             break;
           }
-          case 18: {
-            global::Client.Packets.ChatMessage subBuilder = new global::Client.Packets.ChatMessage();
-            if (msgCase_ == MsgOneofCase.Chat) {
-              subBuilder.MergeFrom(Chat);
+          case 178: {
+            global::Client.Packets.SnapshotMessage subBuilder = new global::Client.Packets.SnapshotMessage();
+            if (msgCase_ == MsgOneofCase.Snapshot) {
+              subBuilder.MergeFrom(Snapshot);
             }
             input.ReadMessage(subBuilder);
-            Chat = subBuilder;
+            Snapshot = subBuilder;
             break;
           }
-          case 26: {
-            global::Client.Packets.IdMessage subBuilder = new global::Client.Packets.IdMessage();
-            if (msgCase_ == MsgOneofCase.Id) {
-              subBuilder.MergeFrom(Id);
+          case 186: {
+            global::Client.Packets.SnapshotAckMessage subBuilder = new global::Client.Packets.SnapshotAckMessage();
+            if (msgCase_ == MsgOneofCase.SnapshotAck) {
+              subBuilder.MergeFrom(SnapshotAck);
             }
             input.ReadMessage(subBuilder);
-            Id = subBuilder;
+            SnapshotAck = subBuilder;
             break;
           }
-          case 34: {
-            global::Client.Packets.LoginRequestMessage subBuilder = new global::Client.Packets.LoginRequestMessage();
-            if (msgCase_ == MsgOneofCase.LoginRequest) {
-              subBuilder.MergeFrom(LoginRequest);
+          case 194: {
+            global::Client.Packets.RoomListRequestMessage subBuilder = new global::Client.Packets.RoomListRequestMessage();
+            if (msgCase_ == MsgOneofCase.RoomListRequest) {
+              subBuilder.MergeFrom(RoomListRequest);
             }
             input.ReadMessage(subBuilder);
-            LoginRequest = subBuilder;
+            RoomListRequest = subBuilder;
             break;
           }
-          case 42: {
-            global::Client.Packets.RegisterRequestMessage subBuilder = new global::Client.Packets.RegisterRequestMessage();
-            if (msgCase_ == MsgOneofCase.RegisterRequest) {
-              subBuilder.MergeFrom(RegisterRequest);
+          case 202: {
+            global::Client.Packets.RoomListMessage subBuilder = new global::Client.Packets.RoomListMessage();
+            if (msgCase_ == MsgOneofCase.RoomList) {
+              subBuilder.MergeFrom(RoomList);
             }
             input.ReadMessage(subBuilder);
-            RegisterRequest = subBuilder;
+            RoomList = subBuilder;
             break;
           }
-          case 50: {
-            global::Client.Packets.OkResponseMessage subBuilder = new global::Client.Packets.OkResponseMessage();
-            if (msgCase_ == MsgOneofCase.OkResponse) {
-              subBuilder.MergeFrom(OkResponse);
+          case 210: {
+            global::Client.Packets.JoinRoomRequestMessage subBuilder = new global::Client.Packets.JoinRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.JoinRoomRequest) {
+              subBuilder.MergeFrom(JoinRoomRequest);
             }
             input.ReadMessage(subBuilder);
-            OkResponse = subBuilder;
+            JoinRoomRequest = subBuilder;
             break;
           }
-          case 58: {
-            global::Client.Packets.DenyResponseMessage subBuilder = new global::Client.Packets.DenyResponseMessage();
-            if (msgCase_ == MsgOneofCase.DenyResponse) {
-              subBuilder.MergeFrom(DenyResponse);
+          case 218: {
+            global::Client.Packets.CreateRoomRequestMessage subBuilder = new global::Client.Packets.CreateRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.CreateRoomRequest) {
+              subBuilder.MergeFrom(CreateRoomRequest);
             }
             input.ReadMessage(subBuilder);
-            DenyResponse = subBuilder;
+            CreateRoomRequest = subBuilder;
             break;
           }
-          case 66: {
-            global::Client.Packets.PlayerMessage subBuilder = new global::Client.Packets.PlayerMessage();
-            if (msgCase_ == MsgOneofCase.Player) {
-              subBuilder.MergeFrom(Player);
+          case 226: {
+            global::Client.Packets.RoomCreatedMessage subBuilder = new global::Client.Packets.RoomCreatedMessage();
+            if (msgCase_ == MsgOneofCase.RoomCreated) {
+              subBuilder.MergeFrom(RoomCreated);
             }
             input.ReadMessage(subBuilder);
-            Player = subBuilder;
+            RoomCreated = subBuilder;
             break;
           }
-          case 74: {
-            global::Client.Packets.PlayerDirectionMessage subBuilder = new global::Client.Packets.PlayerDirectionMessage();
-            if (msgCase_ == MsgOneofCase.PlayerDirection) {
-              subBuilder.MergeFrom(PlayerDirection);
+          case 234: {
+            global::Client.Packets.JoinRoomByCodeRequestMessage subBuilder = new global::Client.Packets.JoinRoomByCodeRequestMessage();
+            if (msgCase_ == MsgOneofCase.JoinRoomByCodeRequest) {
+              subBuilder.MergeFrom(JoinRoomByCodeRequest);
             }
             input.ReadMessage(subBuilder);
-            PlayerDirection = subBuilder;
+            JoinRoomByCodeRequest = subBuilder;
             break;
           }
-          case 82: {
-            global::Client.Packets.SporeMessage subBuilder = new global::Client.Packets.SporeMessage();
-            if (msgCase_ == MsgOneofCase.Spore) {
-              subBuilder.MergeFrom(Spore);
+          case 242: {
+            global::Client.Packets.KickPlayerRequestMessage subBuilder = new global::Client.Packets.KickPlayerRequestMessage();
+            if (msgCase_ == MsgOneofCase.KickPlayerRequest) {
+              subBuilder.MergeFrom(KickPlayerRequest);
             }
             input.ReadMessage(subBuilder);
-            Spore = subBuilder;
+            KickPlayerRequest = subBuilder;
             break;
           }
-          case 90: {
-            global::Client.Packets.SporeConsumedMessage subBuilder = new global::Client.Packets.SporeConsumedMessage();
-            if (msgCase_ == MsgOneofCase.SporeConsumed) {
-              subBuilder.MergeFrom(SporeConsumed);
+          case 250: {
+            global::Client.Packets.LockRoomRequestMessage subBuilder = new global::Client.Packets.LockRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.LockRoomRequest) {
+              subBuilder.MergeFrom(LockRoomRequest);
             }
             input.ReadMessage(subBuilder);
-            SporeConsumed = subBuilder;
+            LockRoomRequest = subBuilder;
             break;
           }
-          case 98: {
-            global::Client.Packets.SporeBatchMessage subBuilder = new global::Client.Packets.SporeBatchMessage();
-            if (msgCase_ == MsgOneofCase.SporeBatch) {
-              subBuilder.MergeFrom(SporeBatch);
+          case 258: {
+            global::Client.Packets.CloseRoomRequestMessage subBuilder = new global::Client.Packets.CloseRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.CloseRoomRequest) {
+              subBuilder.MergeFrom(CloseRoomRequest);
             }
             input.ReadMessage(subBuilder);
-            SporeBatch = subBuilder;
+            CloseRoomRequest = subBuilder;
             break;
           }
-          case 106: {
-            global::Client.Packets.PlayerConsumedMessage subBuilder = new global::Client.Packets.PlayerConsumedMessage();
-            if (msgCase_ == MsgOneofCase.PlayerConsumed) {
-              subBuilder.MergeFrom(PlayerConsumed);
+          case 266: {
+            global::Client.Packets.RemovedFromRoomMessage subBuilder = new global::Client.Packets.RemovedFromRoomMessage();
+            if (msgCase_ == MsgOneofCase.RemovedFromRoom) {
+              subBuilder.MergeFrom(RemovedFromRoom);
             }
             input.ReadMessage(subBuilder);
-            PlayerConsumed = subBuilder;
+            RemovedFromRoom = subBuilder;
             break;
           }
-          case 114: {
-            global::Client.Packets.HiscoreBoardRequestMessage subBuilder = new global::Client.Packets.HiscoreBoardRequestMessage();
-            if (msgCase_ == MsgOneofCase.HiscoreBoardRequest) {
-              subBuilder.MergeFrom(HiscoreBoardRequest);
+          case 274: {
+            global::Client.Packets.GameOverMessage subBuilder = new global::Client.Packets.GameOverMessage();
+            if (msgCase_ == MsgOneofCase.GameOver) {
+              subBuilder.MergeFrom(GameOver);
             }
             input.ReadMessage(subBuilder);
-            HiscoreBoardRequest = subBuilder;
+            GameOver = subBuilder;
             break;
           }
-          case 122: {
-            global::Client.Packets.HiscoreMessage subBuilder = new global::Client.Packets.HiscoreMessage();
-            if (msgCase_ == MsgOneofCase.Hiscore) {
-              subBuilder.MergeFrom(Hiscore);
+          case 282: {
+            global::Client.Packets.TeamAssignmentMessage subBuilder = new global::Client.Packets.TeamAssignmentMessage();
+            if (msgCase_ == MsgOneofCase.TeamAssignment) {
+              subBuilder.MergeFrom(TeamAssignment);
             }
             input.ReadMessage(subBuilder);
-            Hiscore = subBuilder;
+            TeamAssignment = subBuilder;
             break;
           }
-          case 130: {
-            global::Client.Packets.HiscoreBoardMessage subBuilder = new global::Client.Packets.HiscoreBoardMessage();
-            if (msgCase_ == MsgOneofCase.HiscoreBoard) {
-              subBuilder.MergeFrom(HiscoreBoard);
+          case 290: {
+            global::Client.Packets.TeamScoreboardMessage subBuilder = new global::Client.Packets.TeamScoreboardMessage();
+            if (msgCase_ == MsgOneofCase.TeamScoreboard) {
+              subBuilder.MergeFrom(TeamScoreboard);
             }
             input.ReadMessage(subBuilder);
-            HiscoreBoard = subBuilder;
+            TeamScoreboard = subBuilder;
             break;
           }
-          case 138: {
-            global::Client.Packets.FinishedBrowsingHiscoresMessage subBuilder = new global::Client.Packets.FinishedBrowsingHiscoresMessage();
-            if (msgCase_ == MsgOneofCase.FinishBrowsingHiscores) {
-              subBuilder.MergeFrom(FinishBrowsingHiscores);
+          case 298: {
+            global::Client.Packets.RoundCountdownMessage subBuilder = new global::Client.Packets.RoundCountdownMessage();
+            if (msgCase_ == MsgOneofCase.RoundCountdown) {
+              subBuilder.MergeFrom(RoundCountdown);
             }
             input.ReadMessage(subBuilder);
-            FinishBrowsingHiscores = subBuilder;
+            RoundCountdown = subBuilder;
             break;
           }
-          case 146: {
-            global::Client.Packets.SearchHiscoreMessage subBuilder = new global::Client.Packets.SearchHiscoreMessage();
-            if (msgCase_ == MsgOneofCase.SearchHiscore) {
-              subBuilder.MergeFrom(SearchHiscore);
+          case 306: {
+            global::Client.Packets.RoundEndMessage subBuilder = new global::Client.Packets.RoundEndMessage();
+            if (msgCase_ == MsgOneofCase.RoundEnd) {
+              subBuilder.MergeFrom(RoundEnd);
             }
             input.ReadMessage(subBuilder);
-            SearchHiscore = subBuilder;
+            RoundEnd = subBuilder;
             break;
           }
-          case 154: {
-            global::Client.Packets.DisconnectMessage subBuilder = new global::Client.Packets.DisconnectMessage();
-            if (msgCase_ == MsgOneofCase.Disconnect) {
-              subBuilder.MergeFrom(Disconnect);
+          case 314: {
+            global::Client.Packets.ZoneMessage subBuilder = new global::Client.Packets.ZoneMessage();
+            if (msgCase_ == MsgOneofCase.Zone) {
+              subBuilder.MergeFrom(Zone);
             }
             input.ReadMessage(subBuilder);
-            Disconnect = subBuilder;
+            Zone = subBuilder;
+            break;
+          }
+          case 322: {
+            global::Client.Packets.WorldBoundsMessage subBuilder = new global::Client.Packets.WorldBoundsMessage();
+            if (msgCase_ == MsgOneofCase.WorldBounds) {
+              subBuilder.MergeFrom(WorldBounds);
+            }
+            input.ReadMessage(subBuilder);
+            WorldBounds = subBuilder;
+            break;
+          }
+          case 330: {
+            global::Client.Packets.PlayerSplitMessage subBuilder = new global::Client.Packets.PlayerSplitMessage();
+            if (msgCase_ == MsgOneofCase.PlayerSplit) {
+              subBuilder.MergeFrom(PlayerSplit);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerSplit = subBuilder;
+            break;
+          }
+          case 338: {
+            global::Client.Packets.PlayerEjectMessage subBuilder = new global::Client.Packets.PlayerEjectMessage();
+            if (msgCase_ == MsgOneofCase.PlayerEject) {
+              subBuilder.MergeFrom(PlayerEject);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerEject = subBuilder;
+            break;
+          }
+          case 346: {
+            global::Client.Packets.PlayerTargetMessage subBuilder = new global::Client.Packets.PlayerTargetMessage();
+            if (msgCase_ == MsgOneofCase.PlayerTarget) {
+              subBuilder.MergeFrom(PlayerTarget);
+            }
+            input.ReadMessage(subBuilder);
+            PlayerTarget = subBuilder;
+            break;
+          }
+          case 354: {
+            global::Client.Packets.HazardBurstMessage subBuilder = new global::Client.Packets.HazardBurstMessage();
+            if (msgCase_ == MsgOneofCase.HazardBurst) {
+              subBuilder.MergeFrom(HazardBurst);
+            }
+            input.ReadMessage(subBuilder);
+            HazardBurst = subBuilder;
+            break;
+          }
+          case 362: {
+            global::Client.Packets.PowerUpCollectedMessage subBuilder = new global::Client.Packets.PowerUpCollectedMessage();
+            if (msgCase_ == MsgOneofCase.PowerUpCollected) {
+              subBuilder.MergeFrom(PowerUpCollected);
+            }
+            input.ReadMessage(subBuilder);
+            PowerUpCollected = subBuilder;
+            break;
+          }
+          case 370: {
+            global::Client.Packets.PowerUpExpiredMessage subBuilder = new global::Client.Packets.PowerUpExpiredMessage();
+            if (msgCase_ == MsgOneofCase.PowerUpExpired) {
+              subBuilder.MergeFrom(PowerUpExpired);
+            }
+            input.ReadMessage(subBuilder);
+            PowerUpExpired = subBuilder;
+            break;
+          }
+          case 378: {
+            global::Client.Packets.GameMapMessage subBuilder = new global::Client.Packets.GameMapMessage();
+            if (msgCase_ == MsgOneofCase.GameMap) {
+              subBuilder.MergeFrom(GameMap);
+            }
+            input.ReadMessage(subBuilder);
+            GameMap = subBuilder;
+            break;
+          }
+          case 386: {
+            global::Client.Packets.DeathSummaryMessage subBuilder = new global::Client.Packets.DeathSummaryMessage();
+            if (msgCase_ == MsgOneofCase.DeathSummary) {
+              subBuilder.MergeFrom(DeathSummary);
+            }
+            input.ReadMessage(subBuilder);
+            DeathSummary = subBuilder;
+            break;
+          }
+          case 394: {
+            global::Client.Packets.RespawnRequestMessage subBuilder = new global::Client.Packets.RespawnRequestMessage();
+            if (msgCase_ == MsgOneofCase.RespawnRequest) {
+              subBuilder.MergeFrom(RespawnRequest);
+            }
+            input.ReadMessage(subBuilder);
+            RespawnRequest = subBuilder;
+            break;
+          }
+          case 402: {
+            global::Client.Packets.SpectateRoomRequestMessage subBuilder = new global::Client.Packets.SpectateRoomRequestMessage();
+            if (msgCase_ == MsgOneofCase.SpectateRoomRequest) {
+              subBuilder.MergeFrom(SpectateRoomRequest);
+            }
+            input.ReadMessage(subBuilder);
+            SpectateRoomRequest = subBuilder;
+            break;
+          }
+          case 410: {
+            global::Client.Packets.FollowPlayerRequestMessage subBuilder = new global::Client.Packets.FollowPlayerRequestMessage();
+            if (msgCase_ == MsgOneofCase.FollowPlayerRequest) {
+              subBuilder.MergeFrom(FollowPlayerRequest);
+            }
+            input.ReadMessage(subBuilder);
+            FollowPlayerRequest = subBuilder;
+            break;
+          }
+          case 418: {
+            global::Client.Packets.CycleSpectateTargetRequestMessage subBuilder = new global::Client.Packets.CycleSpectateTargetRequestMessage();
+            if (msgCase_ == MsgOneofCase.CycleSpectateTargetRequest) {
+              subBuilder.MergeFrom(CycleSpectateTargetRequest);
+            }
+            input.ReadMessage(subBuilder);
+            CycleSpectateTargetRequest = subBuilder;
+            break;
+          }
+          case 426: {
+            global::Client.Packets.SpectateTargetMessage subBuilder = new global::Client.Packets.SpectateTargetMessage();
+            if (msgCase_ == MsgOneofCase.SpectateTarget) {
+              subBuilder.MergeFrom(SpectateTarget);
+            }
+            input.ReadMessage(subBuilder);
+            SpectateTarget = subBuilder;
             break;
           }
         }
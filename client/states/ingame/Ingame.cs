@@ -3,6 +3,7 @@ using Client.Packets;
 using Godot;
 using Godot.Collections;
 using System;
+using Snapshot = System.Collections.Generic.Dictionary<ulong, Client.Packets.PlayerMessage>;
 
 public partial class Ingame : Node
 {
@@ -20,6 +21,10 @@ public partial class Ingame : Node
     private Dictionary<int, Actor> players = new();
     private Dictionary<int, Spore> spores = new();
 
+    // The players in each snapshot we haven't seen the server move past yet, keyed by sequence number
+    private System.Collections.Generic.Dictionary<ulong, Snapshot> snapshots = new();
+    private bool dead;
+
     public override void _Ready()
     {
         world = GetNode<Node2D>("World");
@@ -70,9 +75,9 @@ public partial class Ingame : Node
         {
             _handleChatMessage(packet.SenderId, packet.Chat);
         }
-        else if (packet.Player != null)
+        else if (packet.Snapshot != null)
         {
-            _handlePlayerMessage(packet.SenderId, packet.Player);
+            _handleSnapshotMessage(packet.SenderId, packet.Snapshot);
         }
         else if (packet.Spore != null)
         {
@@ -86,10 +91,132 @@ public partial class Ingame : Node
         {
             _handleSporeConsumedMessage(packet.SenderId, packet.SporeConsumed);
         }
+        else if (packet.ViewEnter != null)
+        {
+            _handleViewEnterMessage(packet.SenderId, packet.ViewEnter);
+        }
+        else if (packet.ViewLeave != null)
+        {
+            _handleViewLeaveMessage(packet.SenderId, packet.ViewLeave);
+        }
         else if (packet.Disconnect != null)
         {
             _handleDisconnectMessage(packet.SenderId, packet.Disconnect);
         }
+        else if (packet.DeathSummary != null)
+        {
+            _handleDeathSummaryMessage(packet.SenderId, packet.DeathSummary);
+        }
+    }
+
+    public override void _UnhandledInput(InputEvent @event)
+    {
+        if (dead && @event is InputEventKey key && key.Pressed && key.Keycode == Key.Space)
+        {
+            dead = false;
+            var packet = new Packet
+            {
+                RespawnRequest = new RespawnRequestMessage()
+            };
+
+            WS.SendMessageAsync(packet).Wait();
+        }
+    }
+
+    private void _handleSnapshotMessage(ulong senderId, SnapshotMessage snapshot)
+    {
+        var visible = new Snapshot();
+        if (snapshot.Baseline != 0)
+        {
+            if (!snapshots.TryGetValue(snapshot.Baseline, out var baseline))
+            {
+                // Without the baseline we can't make sense of the deltas, the server sends a keyframe once it notices
+                return;
+            }
+
+            foreach (var (id, player) in baseline)
+            {
+                visible[id] = player;
+            }
+        }
+
+        foreach (var id in snapshot.RemovedPlayerIds)
+        {
+            visible.Remove(id);
+        }
+
+        foreach (var delta in snapshot.Players)
+        {
+            visible.TryGetValue(delta.Id, out var player);
+            visible[delta.Id] = _applyPlayerDelta(player, delta);
+        }
+
+        // The server only ever builds on the last snapshot we acknowledged, so anything older can go
+        snapshots[snapshot.Sequence] = visible;
+        foreach (var sequence in new System.Collections.Generic.List<ulong>(snapshots.Keys))
+        {
+            if (sequence < snapshot.Baseline)
+            {
+                snapshots.Remove(sequence);
+            }
+        }
+
+        var packet = new Packet
+        {
+            SnapshotAck = new SnapshotAckMessage
+            {
+                Sequence = snapshot.Sequence
+            }
+        };
+
+        WS.SendMessageAsync(packet).ContinueWith(t =>
+        {
+            if (t.IsFaulted)
+            {
+                GD.PrintErr($"Error sending snapshot ack: {t.Exception}");
+            }
+        });
+
+        foreach (var player in visible.Values)
+        {
+            _handlePlayerMessage(senderId, player);
+        }
+
+        foreach (var actor in new System.Collections.Generic.List<Actor>(players.Values))
+        {
+            if (!visible.ContainsKey((ulong)actor.ActorId))
+            {
+                _removeActor(actor);
+            }
+        }
+    }
+
+    private PlayerMessage _applyPlayerDelta(PlayerMessage baseline, PlayerDeltaMessage delta)
+    {
+        var player = baseline?.Clone() ?? new PlayerMessage { Id = delta.Id };
+
+        if (delta.HasName) player.Name = delta.Name;
+        if (delta.HasX) player.X = delta.X;
+        if (delta.HasY) player.Y = delta.Y;
+        if (delta.HasRadius) player.Radius = delta.Radius;
+        if (delta.HasDirection) player.Direction = delta.Direction;
+        if (delta.HasSpeed) player.Speed = delta.Speed;
+        if (delta.HasColor) player.Color = delta.Color;
+        if (delta.HasTeam) player.Team = delta.Team;
+        if (delta.HasVelocityX) player.VelocityX = delta.VelocityX;
+        if (delta.HasVelocityY) player.VelocityY = delta.VelocityY;
+        if (delta.HasSpawnProtected) player.SpawnProtected = delta.SpawnProtected;
+
+        return player;
+    }
+
+    private void _handleDeathSummaryMessage(ulong senderId, DeathSummaryMessage summary)
+    {
+        var cause = summary.KillerId == 0 ? "You died" : $"You were eaten by {summary.KillerName}";
+        log.Warning($"{cause} after {summary.TimeAliveMs / 1000} seconds");
+        log.Info($"Peak mass {(int)summary.PeakMass}, {summary.Kills} kills, {summary.SporesEaten} spores eaten");
+        log.Info("Press space to respawn");
+        dead = true;
     }
 
     private void _handlePlayerMessage(ulong senderId, PlayerMessage player)
@@ -200,6 +327,26 @@ public partial class Ingame : Node
         }
     }
 
+    // The server only tells us about the spores we can see, starting with the ones already on the map when we join
+    private void _handleViewEnterMessage(ulong senderId, ViewEnterMessage viewEnter)
+    {
+        foreach (var sporeMsg in viewEnter.Spores)
+        {
+            _handleSporeMessage(senderId, sporeMsg);
+        }
+    }
+
+    private void _handleViewLeaveMessage(ulong senderId, ViewLeaveMessage viewLeave)
+    {
+        foreach (var sporeId in viewLeave.SporeIds)
+        {
+            if (spores.ContainsKey((int)sporeId))
+            {
+                _removeSpore(spores[(int)sporeId]);
+            }
+        }
+    }
+
     private void _handleSporeConsumedMessage(ulong senderId, SporeConsumedMessage sporeConsumed)
     {
         if (players.ContainsKey((int)senderId))
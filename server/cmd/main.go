@@ -8,7 +8,10 @@ import (
 	"os"
 	"server/internal/server"
 	"server/internal/server/clients"
+	"server/internal/server/objects"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -20,10 +23,11 @@ const (
 type config struct {
 	Port int
 	DataPath string
+	Room server.RoomConfig
 }
 
 var (
-	defaultConfig = &config{ Port: 8080 }
+	defaultConfig = &config{ Port: 8080, Room: server.DefaultRoomConfig }
 	configPath = flag.String("config", ".env", "Path to the config file")
 )
 
@@ -31,6 +35,32 @@ func loadConfig() *config {
 	cfg := defaultConfig
 	cfg.DataPath = os.Getenv("DATA_PATH")
 
+	cfg.Room.TickRate = positiveIntFromEnv("TICK_RATE", cfg.Room.TickRate)
+	cfg.Room.Capacity = positiveIntFromEnv("ROOM_CAPACITY", cfg.Room.Capacity)
+	cfg.Room.WorldWidth = positiveIntFromEnv("WORLD_WIDTH", cfg.Room.WorldWidth)
+	cfg.Room.WorldHeight = positiveIntFromEnv("WORLD_HEIGHT", cfg.Room.WorldHeight)
+	inertiaMs := nonNegativeIntFromEnv("INERTIA_MS", int(cfg.Room.Inertia.Milliseconds()))
+	cfg.Room.Inertia = time.Duration(inertiaMs) * time.Millisecond
+	cfg.Room.MassDecayRate = nonNegativeFloatFromEnv("MASS_DECAY_RATE", cfg.Room.MassDecayRate)
+	cfg.Room.MaxRadius = positiveFloatFromEnv("MAX_PLAYER_RADIUS", cfg.Room.MaxRadius)
+	cfg.Room.SporeWeights = sporeWeightsFromEnv("SPORE_WEIGHTS", cfg.Room.SporeWeights)
+	if mapPath := os.Getenv("MAP_PATH"); mapPath != "" {
+		gameMap, err := objects.LoadMap(mapPath)
+		if err != nil {
+			log.Printf("Error loading map, using an empty %dx%d world: %v", cfg.Room.WorldWidth, cfg.Room.WorldHeight, err)
+		} else {
+			log.Printf("Loaded map %s", gameMap.Name)
+			cfg.Room.Map = gameMap
+		}
+	}
+	if gameMode := os.Getenv("GAME_MODE"); gameMode != "" {
+		cfg.Room.GameMode = gameMode
+	}
+	if os.Getenv("ROUND_DURATION") != "" {
+		roundSeconds := positiveIntFromEnv("ROUND_DURATION", int(cfg.Room.RoundDuration.Seconds()))
+		cfg.Room.RoundDuration = time.Duration(roundSeconds) * time.Second
+	}
+
 	port, err := strconv.Atoi(os.Getenv("PORT"))
 	if err != nil {
 		log.Printf("Error parsing PORT, using %d", cfg.Port)
@@ -41,6 +71,75 @@ func loadConfig() *config {
 	return cfg
 }
 
+func positiveIntFromEnv(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value <= 0 {
+		log.Printf("Error parsing %s, using %d", name, fallback)
+		return fallback
+	}
+
+	return value
+}
+
+// Like positiveIntFromEnv, but for settings where 0 turns the feature off
+func nonNegativeIntFromEnv(name string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || value < 0 {
+		log.Printf("Error parsing %s, using %d", name, fallback)
+		return fallback
+	}
+
+	return value
+}
+
+func positiveFloatFromEnv(name string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(name), 64)
+	if err != nil || value <= 0 {
+		log.Printf("Error parsing %s, using %g", name, fallback)
+		return fallback
+	}
+
+	return value
+}
+
+func nonNegativeFloatFromEnv(name string, fallback float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(name), 64)
+	if err != nil || value < 0 {
+		log.Printf("Error parsing %s, using %g", name, fallback)
+		return fallback
+	}
+
+	return value
+}
+
+// Parse the common, golden and poison weights from a comma separated list like 90,7,3
+func sporeWeightsFromEnv(name string, fallback server.SporeWeights) server.SporeWeights {
+	parts := strings.Split(os.Getenv(name), ",")
+	if len(parts) != 3 {
+		log.Printf("Error parsing %s, using %v", name, fallback)
+		return fallback
+	}
+
+	weights := make([]int, len(parts))
+	total := 0
+	for i, part := range parts {
+		weight, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || weight < 0 {
+			log.Printf("Error parsing %s, using %v", name, fallback)
+			return fallback
+		}
+		weights[i] = weight
+		total += weight
+	}
+
+	if total == 0 {
+		log.Printf("Error parsing %s, at least one weight must be positive, using %v", name, fallback)
+		return fallback
+	}
+
+	return server.SporeWeights{Common: weights[0], Golden: weights[1], Poison: weights[2]}
+}
+
 func coalescePaths(fallbacks ...string) string {
 	for i, path := range fallbacks {
 		if _, err := os.Stat(path); os.IsNotExist(err) {
@@ -71,7 +170,7 @@ func main() {
 
 	cfg.DataPath = coalescePaths(cfg.DataPath, dockerMountedDataDir, ".")
 
-	hub := server.NewHub(cfg.DataPath)
+	hub := server.NewHub(cfg.DataPath, cfg.Room)
 
 	http.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
 		hub.Serve(clients.NewWebSocketClient, w, r)
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"math"
+	"math/rand/v2"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+const (
+	zoneInitialRadius = 3000.0
+	zoneFinalRadius   = 150.0
+
+	// Seconds it takes the zone to shrink all the way once the game is running
+	zoneShrinkDuration = 300.0
+	zoneUpdateInterval = 1.0
+
+	// Fraction of their mass players outside the zone lose every second, and the least they lose
+	zoneDamageRate    = 0.1
+	zoneMinimumDamage = 100.0
+
+	// Players shrunk below this by the zone are out
+	zoneEliminationRadius = 10.0
+
+	royaleMinPlayers = 2
+)
+
+// Last one standing wins. The safe zone shrinks once enough players are in,
+// anyone caught outside of it wastes away and nobody respawns until the next round.
+type BattleRoyale struct {
+	FreeForAll
+
+	zoneRadius      float64
+	running         bool
+	sinceZoneUpdate float64
+
+	// Players still in the game, and those knocked out in the order they went
+	alive      map[uint64]*objects.Player
+	eliminated []roundStanding
+}
+
+func NewBattleRoyale() *BattleRoyale {
+	return &BattleRoyale{
+		zoneRadius: zoneInitialRadius,
+		alive:      make(map[uint64]*objects.Player),
+	}
+}
+
+func (m *BattleRoyale) Name() string {
+	return "royale"
+}
+
+func (m *BattleRoyale) OnPlayerJoin(w *World, playerId uint64, player *objects.Player) {
+	m.FreeForAll.OnPlayerJoin(w, playerId, player)
+	m.alive[playerId] = player
+
+	w.notifyJoining(playerId, m.zoneMessage())
+}
+
+// Somewhere random inside the zone, away from other players and out of reach of bigger ones if possible
+func (m *BattleRoyale) SpawnPosition(w *World, player *objects.Player) (float64, float64) {
+	const maxTries = 25
+
+	var x, y float64
+	for range maxTries {
+		angle := rand.Float64() * 2 * math.Pi
+		distance := (m.zoneRadius - player.Radius) * math.Sqrt(rand.Float64())
+		x, y = w.bounds.Clamp(distance*math.Cos(angle), distance*math.Sin(angle), player.Radius)
+
+		tooClose := w.gameMap.Blocked(x, y, player.Radius) || objects.InDanger(x, y, player.Radius, w.objects.Players, w.objects.Cells)
+		w.objects.Players.QueryRadius(x, y, player.Radius, func(_ uint64, _ *objects.Player) {
+			tooClose = true
+		})
+		if !tooClose {
+			return x, y
+		}
+	}
+
+	// Nowhere in the zone is clear, but obstacles are solid so at least get out of those
+	return w.gameMap.Place(x, y, player.Radius)
+}
+
+func (m *BattleRoyale) OnPlayerLeave(w *World, playerId uint64) {
+	delete(m.alive, playerId)
+}
+
+func (m *BattleRoyale) Respawns() bool {
+	return false
+}
+
+func (m *BattleRoyale) OnPlayerConsumed(w *World, eaterId, victimId uint64) {
+	m.eliminate(victimId)
+}
+
+func (m *BattleRoyale) Tick(w *World, delta float64) {
+	if !m.running && len(m.alive) >= royaleMinPlayers {
+		w.logger.Printf("%d players in, the zone starts shrinking", len(m.alive))
+		m.running = true
+	}
+
+	if m.running {
+		shrinkRate := (zoneInitialRadius - zoneFinalRadius) / zoneShrinkDuration
+		m.zoneRadius = max(zoneFinalRadius, m.zoneRadius-shrinkRate*delta)
+		m.damageOutsideZone(w, delta)
+	}
+
+	m.sinceZoneUpdate += delta
+	if m.sinceZoneUpdate >= zoneUpdateInterval {
+		m.sinceZoneUpdate = 0
+		w.broadcastToPlayers(m.zoneMessage())
+	}
+
+	if m.running && len(m.alive) <= 1 {
+		var winnerId uint64
+		for playerId := range m.alive {
+			winnerId = playerId
+		}
+		w.EndGame(winnerId)
+	}
+}
+
+// Survivors first, then everyone else from the last to the first knocked out
+func (m *BattleRoyale) Standings(w *World) []roundStanding {
+	standings := w.roundStandings()
+	for i := len(m.eliminated) - 1; i >= 0; i-- {
+		standings = append(standings, m.eliminated[i])
+	}
+	return standings
+}
+
+func (m *BattleRoyale) OnRoundEnd(w *World) {
+	m.zoneRadius = zoneInitialRadius
+	m.running = false
+	m.eliminated = nil
+}
+
+func (m *BattleRoyale) damageOutsideZone(w *World, delta float64) {
+	for playerId, player := range m.alive {
+		for cellId, cell := range player.Cells {
+			if m.shrinkOutsideZone(cell, delta) {
+				w.removeCell(cellId, cell)
+			}
+		}
+
+		// Players only go out once the zone got their main body and they have no cells left to carry on with
+		if m.shrinkOutsideZone(player, delta) && !w.promoteCell(playerId, player) {
+			w.logger.Printf("%s was eliminated by the zone", player.Name)
+			m.eliminate(playerId)
+			w.eliminatePlayer(playerId)
+		}
+	}
+}
+
+// Take the zone's toll on the body if it's outside, returns whether the body wasted away completely
+func (m *BattleRoyale) shrinkOutsideZone(body objects.Body, delta float64) bool {
+	if x, y := body.Position(); math.Hypot(x, y) <= m.zoneRadius {
+		return false
+	}
+
+	mass := objects.RadToMass(body.Size())
+	damage := max(mass*zoneDamageRate, zoneMinimumDamage) * delta
+	if mass-damage < objects.RadToMass(zoneEliminationRadius) {
+		return true
+	}
+
+	body.Grow(-damage)
+	return false
+}
+
+func (m *BattleRoyale) eliminate(playerId uint64) {
+	player, exists := m.alive[playerId]
+	if !exists {
+		return
+	}
+
+	delete(m.alive, playerId)
+	m.eliminated = append(m.eliminated, roundStanding{
+		playerId: playerId,
+		player:   player,
+		score:    m.Score(player),
+	})
+}
+
+func (m *BattleRoyale) zoneMessage() packets.Msg {
+	targetRadius := m.zoneRadius
+	if m.running {
+		targetRadius = zoneFinalRadius
+	}
+
+	return packets.NewZone(0, 0, m.zoneRadius, targetRadius)
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"sync"
 	"server/internal/server"
 	"server/internal/server/states"
 	"server/pkg/packets"
@@ -22,6 +23,15 @@ type WebSocketClient struct {
 	state						server.ClientStateHandler
 	logger					*log.Logger
 	dbTx 						*server.DbTx
+
+	// Everything that touches the state is queued here and run by the process pump,
+	// so the socket, the hub, rooms and worlds never race each other over it. Nothing
+	// queued is ever dropped, the read pump waits for each packet to be handled instead.
+	inbox						[]func()
+	inboxMux				sync.Mutex
+	wake						chan struct{}
+	done						chan struct{}
+	closed					bool
 }
 
 func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *http.Request) (server.ClientInterfacer, error) {
@@ -41,6 +51,8 @@ func NewWebSocketClient(hub *server.Hub, writer http.ResponseWriter, request *ht
 		hub: 				hub,
 		conn: 			conn,
 		sendChan: 	make(chan *packets.Packet, 256),
+		wake: 			make(chan struct{}, 1),
+		done: 			make(chan struct{}),
 		logger: 		log.New(log.Writer(), "Client unknown: ", log.LstdFlags),
 		dbTx: 			hub.NewDbTx(),
 	}
@@ -74,14 +86,63 @@ func (c *WebSocketClient) SetState(newState server.ClientStateHandler) {
 	}
 }
 
+// Hand the message to the state on the process pump, it's safe to call from any goroutine
 func (c *WebSocketClient) ProcessMessage(senderId uint64, message packets.Msg) {
+	c.queue(func() {
+		c.handleMessage(senderId, message)
+	})
+}
+
+func (c *WebSocketClient) handleMessage(senderId uint64, message packets.Msg) {
+	if c.state == nil {
+		return
+	}
+
 	c.state.HandleMessage(senderId, message)
 }
 
+func (c *WebSocketClient) queue(action func()) {
+	select {
+	case <-c.done:
+		return
+	default:
+	}
+
+	c.inboxMux.Lock()
+	c.inbox = append(c.inbox, action)
+	c.inboxMux.Unlock()
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run the queued state work one at a time until the client closes
+func (c *WebSocketClient) processPump() {
+	for range c.wake {
+		c.inboxMux.Lock()
+		actions := c.inbox
+		c.inbox = nil
+		c.inboxMux.Unlock()
+
+		for _, action := range actions {
+			action()
+			if c.closed {
+				close(c.done)
+				return
+			}
+		}
+	}
+}
+
 func (c *WebSocketClient) Initialize(id uint64) {
 	c.id = id
 	c.logger.SetPrefix((fmt.Sprintf("Client %d: ", c.id)))
-	c.SetState(&states.Connected{})
+	c.queue(func() {
+		c.SetState(&states.Connected{})
+	})
+	go c.processPump()
 }
 
 func (c *WebSocketClient) SocketSend(message packets.Msg) {
@@ -102,8 +163,11 @@ func (c *WebSocketClient) PassToPeer(message packets.Msg, peerId uint64) {
 	}
 }
 
+// Pass the message to everyone else in the client's room, clients outside a room have nobody to tell
 func (c *WebSocketClient) Broadcast(message packets.Msg) {
-	c.hub.BroadcastChan <- &packets.Packet{SenderId: c.id, Msg: message}
+	if room, inRoom := c.hub.RoomOf(c.id); inRoom {
+		room.Broadcast(c.id, message)
+	}
 }
 
 func (c *WebSocketClient) ReadPump() {
@@ -132,7 +196,18 @@ func (c *WebSocketClient) ReadPump() {
 			packet.SenderId = c.id
 		}
 
-		c.ProcessMessage(packet.SenderId, packet.Msg)
+		// Don't read the next packet before this one is handled, so a client flooding us only slows itself down
+		handled := make(chan struct{})
+		c.queue(func() {
+			c.handleMessage(packet.SenderId, packet.Msg)
+			close(handled)
+		})
+
+		select {
+		case <-handled:
+		case <-c.done:
+			return
+		}
 	}
 
 }
@@ -182,8 +257,8 @@ func (c *WebSocketClient) DbTx() *server.DbTx {
 	return c.dbTx
 }
 
-func (c *WebSocketClient) SharedGameObjects() *server.SharedGameObjects {
-	return c.hub.SharedGameObjects
+func (c *WebSocketClient) Hub() *server.Hub {
+	return c.hub
 }
 
 func (c *WebSocketClient) Close(reason string) {
@@ -191,7 +266,10 @@ func (c *WebSocketClient) Close(reason string) {
 
 	c.Broadcast(packets.NewDisconnect(reason))
 
-	c.SetState(nil)
+	c.queue(func() {
+		c.SetState(nil)
+		c.closed = true
+	})
 
 	c.hub.UnregisterChan <- c
 	c.conn.Close()
@@ -24,8 +24,8 @@ WHERE user_id = ? LIMIT 1;
 
 -- name: UpdatePlayerBestScore :exec
 UPDATE players
-SET best_score = ?
-WHERE id = ?;
+SET best_score = MAX(best_score, sqlc.arg(best_score))
+WHERE id = sqlc.arg(id);
 
 -- name: GetTopScores :many
 SELECT name, best_score
@@ -44,4 +44,40 @@ SELECT COUNT(*) + 1 AS "rank" FROM players
 WHERE best_score >= (
     SELECT best_score FROM players p2
     WHERE p2.id = ?
-);
\ No newline at end of file
+);
+
+-- name: CreateRound :one
+INSERT INTO rounds (
+    room_name, game_mode, started_at, ended_at
+) VALUES (
+    ?, ?, ?, ?
+)
+RETURNING *;
+
+-- name: CreateRoundResult :exec
+INSERT INTO round_results (
+    round_id, player_id, mass, placement
+) VALUES (
+    ?, ?, ?, ?
+);
+
+-- name: GetRecentRounds :many
+SELECT * FROM rounds
+ORDER BY ended_at DESC
+LIMIT ?
+OFFSET ?;
+
+-- name: GetRoundResults :many
+SELECT players.name, round_results.mass, round_results.placement
+FROM round_results
+JOIN players ON players.id = round_results.player_id
+WHERE round_results.round_id = ?
+ORDER BY round_results.placement;
+
+-- name: GetPlayerRoundHistory :many
+SELECT rounds.id, rounds.game_mode, rounds.ended_at, round_results.mass, round_results.placement
+FROM round_results
+JOIN rounds ON rounds.id = round_results.round_id
+WHERE round_results.player_id = ?
+ORDER BY rounds.ended_at DESC
+LIMIT ?;
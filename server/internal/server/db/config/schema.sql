@@ -11,4 +11,22 @@ CREATE TABLE IF NOT EXISTS players (
     best_score INTEGER NOT NULL DEFAULT 0,
     color INTEGER NOT NULL,
     FOREIGN KEY (user_id) REFERENCES users(id)
-);
\ No newline at end of file
+);
+
+CREATE TABLE IF NOT EXISTS rounds (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    room_name TEXT NOT NULL,
+    game_mode TEXT NOT NULL,
+    started_at INTEGER NOT NULL,
+    ended_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS round_results (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    round_id INTEGER NOT NULL,
+    player_id INTEGER NOT NULL,
+    mass INTEGER NOT NULL,
+    placement INTEGER NOT NULL,
+    FOREIGN KEY (round_id) REFERENCES rounds(id),
+    FOREIGN KEY (player_id) REFERENCES players(id)
+);
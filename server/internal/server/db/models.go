@@ -12,6 +12,22 @@ type Player struct {
 	Color     int64
 }
 
+type Round struct {
+	ID        int64
+	RoomName  string
+	GameMode  string
+	StartedAt int64
+	EndedAt   int64
+}
+
+type RoundResult struct {
+	ID        int64
+	RoundID   int64
+	PlayerID  int64
+	Mass      int64
+	Placement int64
+}
+
 type User struct {
 	ID           int64
 	Username     string
@@ -37,6 +37,65 @@ func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Pla
 	return i, err
 }
 
+const createRound = `-- name: CreateRound :one
+INSERT INTO rounds (
+    room_name, game_mode, started_at, ended_at
+) VALUES (
+    ?, ?, ?, ?
+)
+RETURNING id, room_name, game_mode, started_at, ended_at
+`
+
+type CreateRoundParams struct {
+	RoomName  string
+	GameMode  string
+	StartedAt int64
+	EndedAt   int64
+}
+
+func (q *Queries) CreateRound(ctx context.Context, arg CreateRoundParams) (Round, error) {
+	row := q.db.QueryRowContext(ctx, createRound,
+		arg.RoomName,
+		arg.GameMode,
+		arg.StartedAt,
+		arg.EndedAt,
+	)
+	var i Round
+	err := row.Scan(
+		&i.ID,
+		&i.RoomName,
+		&i.GameMode,
+		&i.StartedAt,
+		&i.EndedAt,
+	)
+	return i, err
+}
+
+const createRoundResult = `-- name: CreateRoundResult :exec
+INSERT INTO round_results (
+    round_id, player_id, mass, placement
+) VALUES (
+    ?, ?, ?, ?
+)
+`
+
+type CreateRoundResultParams struct {
+	RoundID   int64
+	PlayerID  int64
+	Mass      int64
+	Placement int64
+}
+
+func (q *Queries) CreateRoundResult(ctx context.Context, arg CreateRoundResultParams) error {
+	_, err := q.db.ExecContext(ctx, createRoundResult,
+		arg.RoundID,
+		arg.PlayerID,
+		arg.Mass,
+		arg.Placement,
+	)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
   username, password_hash
@@ -110,6 +169,135 @@ func (q *Queries) GetPlayerRank(ctx context.Context, id int64) (int64, error) {
 	return rank, err
 }
 
+const getPlayerRoundHistory = `-- name: GetPlayerRoundHistory :many
+SELECT rounds.id, rounds.game_mode, rounds.ended_at, round_results.mass, round_results.placement
+FROM round_results
+JOIN rounds ON rounds.id = round_results.round_id
+WHERE round_results.player_id = ?
+ORDER BY rounds.ended_at DESC
+LIMIT ?
+`
+
+type GetPlayerRoundHistoryParams struct {
+	PlayerID int64
+	Limit    int64
+}
+
+type GetPlayerRoundHistoryRow struct {
+	ID        int64
+	GameMode  string
+	EndedAt   int64
+	Mass      int64
+	Placement int64
+}
+
+func (q *Queries) GetPlayerRoundHistory(ctx context.Context, arg GetPlayerRoundHistoryParams) ([]GetPlayerRoundHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPlayerRoundHistory, arg.PlayerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayerRoundHistoryRow
+	for rows.Next() {
+		var i GetPlayerRoundHistoryRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameMode,
+			&i.EndedAt,
+			&i.Mass,
+			&i.Placement,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentRounds = `-- name: GetRecentRounds :many
+SELECT id, room_name, game_mode, started_at, ended_at FROM rounds
+ORDER BY ended_at DESC
+LIMIT ?
+OFFSET ?
+`
+
+type GetRecentRoundsParams struct {
+	Limit  int64
+	Offset int64
+}
+
+func (q *Queries) GetRecentRounds(ctx context.Context, arg GetRecentRoundsParams) ([]Round, error) {
+	rows, err := q.db.QueryContext(ctx, getRecentRounds, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Round
+	for rows.Next() {
+		var i Round
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoomName,
+			&i.GameMode,
+			&i.StartedAt,
+			&i.EndedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoundResults = `-- name: GetRoundResults :many
+SELECT players.name, round_results.mass, round_results.placement
+FROM round_results
+JOIN players ON players.id = round_results.player_id
+WHERE round_results.round_id = ?
+ORDER BY round_results.placement
+`
+
+type GetRoundResultsRow struct {
+	Name      string
+	Mass      int64
+	Placement int64
+}
+
+func (q *Queries) GetRoundResults(ctx context.Context, roundID int64) ([]GetRoundResultsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRoundResults, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRoundResultsRow
+	for rows.Next() {
+		var i GetRoundResultsRow
+		if err := rows.Scan(&i.Name, &i.Mass, &i.Placement); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTopScores = `-- name: GetTopScores :many
 SELECT name, best_score
 FROM players
@@ -165,8 +353,8 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 
 const updatePlayerBestScore = `-- name: UpdatePlayerBestScore :exec
 UPDATE players
-SET best_score = ?
-WHERE id = ?
+SET best_score = MAX(best_score, ?1)
+WHERE id = ?2
 `
 
 type UpdatePlayerBestScoreParams struct {
@@ -0,0 +1,16 @@
+package server
+
+// How many database writes can queue up before the simulation has to wait on them
+const dbWriteBacklog = 256
+
+// Queue a database write for the world's writer goroutine. It keeps the round trip
+// off the simulation goroutine, and writes land in the order they were queued.
+func (w *World) writeToDb(write func()) {
+	w.dbWrites <- write
+}
+
+func (w *World) runDbWrites() {
+	for write := range w.dbWrites {
+		write()
+	}
+}
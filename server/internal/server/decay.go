@@ -0,0 +1,41 @@
+package server
+
+import "server/internal/server/objects"
+
+const (
+	// Fraction of their mass big players lose every second
+	DefaultMassDecayRate = 0.002
+	DefaultMaxRadius     = 500.0
+
+	// Bodies smaller than this don't decay
+	minDecayRadius = 50.0
+)
+
+// Big bodies slowly waste away, so nobody can sit on top of the leaderboard forever
+func (w *World) decayPlayer(player *objects.Player, delta float64) {
+	if w.massDecayRate <= 0 {
+		return
+	}
+
+	for _, body := range bodies(player) {
+		if body.Size() <= minDecayRadius {
+			continue
+		}
+
+		decay := objects.RadToMass(body.Size()) * w.massDecayRate * delta
+		body.Grow(-min(decay, objects.RadToMass(body.Size())-objects.RadToMass(minDecayRadius)))
+	}
+}
+
+// Cut every body down to the maximum radius
+func (w *World) capPlayerSize(player *objects.Player) {
+	if player.Radius > w.maxRadius {
+		player.Radius = w.maxRadius
+	}
+
+	for _, cell := range player.Cells {
+		if cell.Radius > w.maxRadius {
+			cell.Radius = w.maxRadius
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"time"
+)
+
+const (
+	ejectCooldown    = 250 * time.Millisecond
+	ejectSporeRadius = 12.0
+
+	// Players smaller than this can't spare the mass
+	minEjectRadius = 30.0
+
+	ejectSpeed = 800.0
+
+	// Fraction of its speed an ejected spore still has after a second
+	ejectFriction = 0.02
+
+	// Below this speed an ejected spore is considered at rest
+	ejectRestSpeed = 5.0
+)
+
+// Fire a spore out of the player in the direction they're facing, at the cost of its mass
+func (w *World) ejectMass(player *objects.Player) {
+	if player.Radius < minEjectRadius || time.Since(player.LastEjectAt) < ejectCooldown {
+		return
+	}
+
+	dirX, dirY := math.Cos(player.Direction), math.Sin(player.Direction)
+	spore := &objects.Spore{
+		Radius:    ejectSporeRadius,
+		DroppedBy: player,
+		DroppedAt: time.Now(),
+		VelocityX: ejectSpeed * dirX,
+		VelocityY: ejectSpeed * dirY,
+	}
+
+	// Start right outside the player, so it doesn't need to travel through them
+	offset := player.Radius + spore.Radius
+	spore.X, spore.Y = w.gameMap.Place(player.X+dirX*offset, player.Y+dirY*offset, spore.Radius)
+
+	player.Grow(-objects.RadToMass(spore.Radius))
+	player.LastEjectAt = spore.DroppedAt
+	w.movingSpores[w.objects.Spores.Add(spore)] = spore
+}
+
+// Slide every ejected spore that hasn't come to rest yet
+func (w *World) moveSpores(delta float64) {
+	friction := math.Pow(ejectFriction, delta)
+	for sporeId, spore := range w.movingSpores {
+		// It might have been eaten mid-flight. Spores only stop being tracked the tick
+		// after their last move, so viewers still get sent where they ended up.
+		_, exists := w.objects.Spores.Get(sporeId)
+		if !exists || math.Hypot(spore.VelocityX, spore.VelocityY) < ejectRestSpeed {
+			spore.VelocityX, spore.VelocityY = 0, 0
+			delete(w.movingSpores, sporeId)
+			continue
+		}
+
+		x := spore.X + spore.VelocityX*delta
+		y := spore.Y + spore.VelocityY*delta
+		spore.X, spore.Y = w.gameMap.Place(x, y, spore.Radius)
+
+		spore.VelocityX *= friction
+		spore.VelocityY *= friction
+		w.objects.Spores.Reindex(sporeId)
+	}
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"time"
+)
+
+const (
+	initialPlayerRadius = 20.0
+	initialPlayerSpeed  = 150.0
+)
+
+// Everyone against everyone, forever. Other modes embed it and override the rules they change.
+type FreeForAll struct{}
+
+func (m *FreeForAll) Name() string {
+	return "ffa"
+}
+
+func (m *FreeForAll) OnPlayerJoin(w *World, playerId uint64, player *objects.Player) {
+	player.Radius = initialPlayerRadius
+	player.Speed = initialPlayerSpeed
+}
+
+func (m *FreeForAll) SpawnPosition(w *World, player *objects.Player) (float64, float64) {
+	return objects.SafeSpawnCoords(player.Radius, w.gameMap.SpawnArea(), w.gameMap, w.objects.Players, w.objects.Cells, w.objects.Hazards)
+}
+
+func (m *FreeForAll) OnPlayerLeave(w *World, playerId uint64) {
+}
+
+func (m *FreeForAll) Respawns() bool {
+	return true
+}
+
+func (m *FreeForAll) CanConsumePlayer(eater, victim *objects.Player) bool {
+	return objects.RadToMass(eater.Radius) > objects.RadToMass(victim.Radius)*1.5
+}
+
+func (m *FreeForAll) CanConsumeSpore(player *objects.Player, spore *objects.Spore) bool {
+	// Don't let players immediately eat back what they just dropped
+	const buffer = 10.0
+	minAcceptableDistance := spore.Radius + player.Radius + buffer
+	minAcceptableTime := time.Duration(minAcceptableDistance/player.Speed*1000) * time.Millisecond
+	return spore.DroppedBy != player || time.Since(spore.DroppedAt) >= minAcceptableTime
+}
+
+func (m *FreeForAll) SporeDropProbability(player *objects.Player) float64 {
+	if player.Radius <= 10 {
+		return 0
+	}
+
+	return player.Radius / float64(MaxSpores*5)
+}
+
+func (m *FreeForAll) OnPlayerConsumed(w *World, eaterId, victimId uint64) {
+}
+
+func (m *FreeForAll) Score(player *objects.Player) int64 {
+	return int64(math.Floor(player.Mass()))
+}
+
+func (m *FreeForAll) Tick(w *World, delta float64) {
+}
+
+func (m *FreeForAll) Standings(w *World) []roundStanding {
+	return w.roundStandings()
+}
+
+func (m *FreeForAll) OnRoundEnd(w *World) {
+}
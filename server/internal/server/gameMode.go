@@ -0,0 +1,61 @@
+package server
+
+import (
+	"fmt"
+	"server/internal/server/objects"
+)
+
+const DefaultGameMode = "ffa"
+
+// A GameMode owns the rules of a room: how players spawn, what they can eat,
+// how they score and when the game is won. Every hook is called from the
+// world goroutine, so modes can keep their own state without locking.
+type GameMode interface {
+	Name() string
+
+	// Set up a player entering the world, before they are placed in it
+	OnPlayerJoin(w *World, playerId uint64, player *objects.Player)
+
+	SpawnPosition(w *World, player *objects.Player) (float64, float64)
+
+	OnPlayerLeave(w *World, playerId uint64)
+
+	// Whether consumed players get to play again, checked by the client state of the victim
+	Respawns() bool
+
+	CanConsumePlayer(eater, victim *objects.Player) bool
+
+	CanConsumeSpore(player *objects.Player, spore *objects.Spore) bool
+
+	// The chance for the player to lose a spore on any given tick
+	SporeDropProbability(player *objects.Player) float64
+
+	OnPlayerConsumed(w *World, eaterId, victimId uint64)
+
+	Score(player *objects.Player) int64
+
+	// Called at the end of every tick once collisions are resolved, win
+	// conditions are checked here and end the game through World.EndGame
+	Tick(w *World, delta float64)
+
+	// The final placements of a round, best first
+	Standings(w *World) []roundStanding
+
+	// Clear any per-round state, right before the world is reset for the next round
+	OnRoundEnd(w *World)
+}
+
+var gameModes = map[string]func() GameMode{
+	"ffa":    func() GameMode { return &FreeForAll{} },
+	"teams":  func() GameMode { return NewTeams(defaultTeamCount) },
+	"royale": func() GameMode { return NewBattleRoyale() },
+}
+
+func NewGameMode(name string) (GameMode, error) {
+	newMode, exists := gameModes[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown game mode %q", name)
+	}
+
+	return newMode(), nil
+}
@@ -0,0 +1,87 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+)
+
+const (
+	MaxHazards = 30
+
+	hazardRadius         = 50.0
+	hazardReplenishBatch = 2
+
+	// Bodies need to be this much bigger than a hazard for it to burst them
+	hazardBurstRatio = 1.15
+
+	// How many cells a burst body breaks into on top of itself
+	hazardBurstPieces = 8
+
+	// Fraction of its mass a body loses instead, when its player can't split any further
+	hazardDamage = 0.3
+)
+
+// Scatter hazards over the world before the simulation starts
+func (w *World) seedHazards() {
+	w.logger.Println("Placing hazards...")
+	for i := 0; i < MaxHazards; i++ {
+		w.objects.Hazards.Add(w.newHazard())
+	}
+}
+
+func (w *World) replenishHazards(limit int) {
+	diff := MaxHazards - w.objects.Hazards.Len()
+	if diff <= 0 {
+		return
+	}
+
+	w.logger.Printf("Replenishing %d hazards", min(diff, limit))
+	for i := 0; i < min(diff, limit); i++ {
+		w.objects.Hazards.Add(w.newHazard())
+	}
+}
+
+func (w *World) newHazard() *objects.Hazard {
+	x, y := objects.SpawnCoords(hazardRadius, w.bounds, w.gameMap, w.objects.Players, w.objects.Spores, w.objects.Hazards)
+	return &objects.Hazard{
+		X:      x,
+		Y:      y,
+		Radius: hazardRadius,
+	}
+}
+
+// Burst the body on the first hazard it's big enough to swallow the middle of
+func (w *World) hitHazards(playerId uint64, player *objects.Player, body objects.Body) {
+	x, y := body.Position()
+	hit := false
+	w.objects.Hazards.QueryRadius(x, y, body.Size(), func(hazardId uint64, hazard *objects.Hazard) {
+		if hit || body.Size() < hazard.Radius*hazardBurstRatio {
+			return
+		}
+
+		if math.Hypot(hazard.X-x, hazard.Y-y) >= body.Size() {
+			return
+		}
+
+		hit = true
+		w.objects.Hazards.Remove(hazardId)
+		w.burstBody(playerId, player, body)
+		w.notifyHazardBurst(playerId, hazardId)
+	})
+}
+
+// Break the body into pieces flying off in every direction, or just take a chunk out of it if the player can't split any further
+func (w *World) burstBody(playerId uint64, player *objects.Player, body objects.Body) {
+	mass := objects.RadToMass(body.Size())
+	pieces := min(hazardBurstPieces, maxPlayerCells-len(player.Cells)-1)
+	if pieces <= 0 {
+		body.Grow(-mass * hazardDamage)
+		return
+	}
+
+	share := mass / float64(pieces+1)
+	body.Grow(-share * float64(pieces))
+	for i := range pieces {
+		w.launchCell(playerId, player, body, share, 2*math.Pi*float64(i)/float64(pieces))
+	}
+}
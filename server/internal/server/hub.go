@@ -11,11 +11,13 @@ import (
 	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
-	"time"
+	"strings"
+	"sync"
 
 	_ "modernc.org/sqlite"
 )
 
+// Spores in a world whose map doesn't define any spore regions
 const MaxSpores = 1000
 
 //go:embed db/config/schema.sql
@@ -37,6 +39,10 @@ type SharedGameObjects struct {
 	// The ID of the player is the ID of the client
 	Players *objects.SharedCollection[*objects.Player]
 	Spores 	*objects.SharedCollection[*objects.Spore]
+	// Pieces split off from players, each one is also tracked by its owner
+	Cells    *objects.SharedCollection[*objects.Cell]
+	Hazards  *objects.SharedCollection[*objects.Hazard]
+	PowerUps *objects.SharedCollection[*objects.PowerUp]
 }
 
 type ClientStateHandler interface {
@@ -75,7 +81,7 @@ type ClientInterfacer interface {
 
 	DbTx() *DbTx
 
-	SharedGameObjects() *SharedGameObjects
+	Hub() *Hub
 
 	Close(reason string)
 }
@@ -83,18 +89,21 @@ type ClientInterfacer interface {
 type Hub struct {
 	Clients *objects.SharedCollection[ClientInterfacer]
 
-	BroadcastChan chan *packets.Packet
-
 	RegisterChan chan ClientInterfacer
 
 	UnregisterChan chan ClientInterfacer
 
 	dbPool *sql.DB
 
-	SharedGameObjects *SharedGameObjects
+	Rooms *objects.SharedCollection[*Room]
+
+	roomConfig RoomConfig
+
+	// Serialises picking, creating and destroying rooms
+	roomsMux sync.Mutex
 }
 
-func NewHub(dataDirPath string) *Hub {
+func NewHub(dataDirPath string, roomConfig RoomConfig) *Hub {
 	dbPool, err := sql.Open("sqlite", path.Join(dataDirPath, "db.sqlite"))
 	if err != nil {
 		log.Fatalf("Failed to open database: %v", err)
@@ -102,14 +111,11 @@ func NewHub(dataDirPath string) *Hub {
 
 	return &Hub{
 		Clients:				objects.NewSharedCollection[ClientInterfacer](),
-		BroadcastChan:	make(chan *packets.Packet),
 		RegisterChan:		make(chan ClientInterfacer),
 		UnregisterChan:	make(chan ClientInterfacer),
 		dbPool:					dbPool,
-		SharedGameObjects: &SharedGameObjects{
-			Players: objects.NewSharedCollection[*objects.Player](),
-			Spores:  objects.NewSharedCollection[*objects.Spore](),
-		},
+		Rooms:					objects.NewSharedCollection[*Room](),
+		roomConfig:			roomConfig,
 	}
 }
 
@@ -119,13 +125,6 @@ func (h *Hub) Run() {
 		log.Fatalf("Failed to initialize database schema: %v", err)
 	}
 
-	log.Println("Placing spores...")
-	for i := 0; i < MaxSpores; i++ {
-		h.SharedGameObjects.Spores.Add(h.newSpore())
-	}
-
-	go h.repenishSporesLoop(5)
-
 	log.Println("Awaiting client registrations")
 	for {
 		select {
@@ -133,13 +132,7 @@ func (h *Hub) Run() {
 			client.Initialize(h.Clients.Add(client))
 		case client := <-h.UnregisterChan:
 			h.Clients.Remove(client.Id())
-		case packet := <-h.BroadcastChan:
-			// for _, client := range h.Clients {
-			h.Clients.ForEach(func(id uint64, client ClientInterfacer) {
-				if id != packet.SenderId {
-					client.ProcessMessage(packet.SenderId, packet.Msg)
-				}
-			})
+			h.destroyEmptyRoomsOwnedBy(client.Id())
 		}
 	}
 }
@@ -159,38 +152,171 @@ func (h *Hub) Serve(getNewClient func(*Hub, http.ResponseWriter, *http.Request)
 	go client.ReadPump()
 }
 
-func (h *Hub) newSpore() *objects.Spore {
-	sporeRadius := max(10 + rand.NormFloat64() * 3, 5)
-	x, y := objects.SpawnCoords(sporeRadius, h.SharedGameObjects.Players, h.SharedGameObjects.Spores)
-	return &objects.Spore{
-		X:      x,
-		Y:      y,
-		Radius: sporeRadius,
+// Find the fullest room that still has space for another player, creating one if they're all full
+func (h *Hub) Matchmake() *Room {
+	h.roomsMux.Lock()
+	defer h.roomsMux.Unlock()
+
+	var best *Room
+	bestCount := -1
+	h.Rooms.ForEach(func(_ uint64, room *Room) {
+		if room.Private || !room.hasSpace() {
+			return
+		}
+
+		if count := room.PlayerCount(); count > bestCount {
+			best, bestCount = room, count
+		}
+	})
+
+	if best != nil {
+		return best
 	}
+
+	return h.createRoom()
 }
 
-func (h *Hub) repenishSporesLoop(rate time.Duration) {
-	ticker := time.NewTicker(rate * time.Second)
-	defer ticker.Stop()
+// The public room with the most players, full or not, since watching doesn't take up a slot
+func (h *Hub) BusiestRoom() (*Room, bool) {
+	h.roomsMux.Lock()
+	defer h.roomsMux.Unlock()
 
-	for range ticker.C {
-		sporesRemaining := h.SharedGameObjects.Spores.Len()
-		diff := MaxSpores - sporesRemaining
-		if diff <= 0 {
-			continue
+	var best *Room
+	bestCount := -1
+	h.Rooms.ForEach(func(_ uint64, room *Room) {
+		if room.Private {
+			return
 		}
 
-		log.Printf("Replenishing %d spores", diff)
-		for i := 0; i < min(diff, 10); i++ {
-			spore := h.newSpore()
-			sporeId := h.SharedGameObjects.Spores.Add(spore)
+		if count := room.PlayerCount(); count > bestCount {
+			best, bestCount = room, count
+		}
+	})
+
+	return best, best != nil
+}
 
-			h.BroadcastChan <- &packets.Packet{
-				SenderId: 0,
-				Msg:			packets.NewSpore(sporeId, spore),
-			}
+func (h *Hub) CreateRoom() *Room {
+	h.roomsMux.Lock()
+	defer h.roomsMux.Unlock()
 
-			time.Sleep(50 * time.Millisecond)
+	return h.createRoom()
+}
+
+// Create a room only reachable with its invite code, owned by the client that asked for it
+func (h *Hub) CreatePrivateRoom(ownerId uint64, name string, capacity int) (*Room, error) {
+	h.roomsMux.Lock()
+	defer h.roomsMux.Unlock()
+
+	owned := 0
+	h.Rooms.ForEach(func(_ uint64, room *Room) {
+		if room.Private && room.OwnerId == ownerId {
+			owned++
 		}
+	})
+	if owned >= maxPrivateRoomsPerOwner {
+		return nil, ErrTooManyRooms
 	}
-}
\ No newline at end of file
+
+	room := newRoom(h, h.roomConfig)
+	room.Private = true
+	room.OwnerId = ownerId
+	room.InviteCode = h.newInviteCode()
+	if capacity > 0 && capacity < room.Capacity {
+		room.Capacity = capacity
+	}
+
+	// The world keeps its own copy of the name, so it has to be set before the room starts
+	room.Name = name
+	room.start(h.Rooms.Add(room))
+
+	log.Printf("Created private %s for client %d", room.Name, ownerId)
+	return room, nil
+}
+
+func (h *Hub) FindRoomByCode(inviteCode string) (*Room, bool) {
+	var found *Room
+	h.Rooms.ForEach(func(_ uint64, room *Room) {
+		if room.Private && strings.EqualFold(room.InviteCode, inviteCode) {
+			found = room
+		}
+	})
+
+	return found, found != nil
+}
+
+// The room the client is playing or spectating in
+func (h *Hub) RoomOf(clientId uint64) (*Room, bool) {
+	var found *Room
+	h.Rooms.ForEach(func(_ uint64, room *Room) {
+		if room.Has(clientId) {
+			found = room
+		}
+	})
+
+	return found, found != nil
+}
+
+func (h *Hub) DestroyRoom(roomId uint64) {
+	h.roomsMux.Lock()
+	defer h.roomsMux.Unlock()
+
+	h.destroyRoom(roomId)
+}
+
+func (h *Hub) destroyRoomIfEmpty(roomId uint64) {
+	h.roomsMux.Lock()
+	defer h.roomsMux.Unlock()
+
+	if room, exists := h.Rooms.Get(roomId); exists && room.IsEmpty() {
+		h.destroyRoom(roomId)
+	}
+}
+
+// Empty rooms are only torn down once somebody has left them, so a private room
+// whose invite code was never used goes when its owner disconnects
+func (h *Hub) destroyEmptyRoomsOwnedBy(ownerId uint64) {
+	h.roomsMux.Lock()
+	defer h.roomsMux.Unlock()
+
+	h.Rooms.ForEach(func(roomId uint64, room *Room) {
+		if room.Private && room.OwnerId == ownerId && room.IsEmpty() {
+			h.destroyRoom(roomId)
+		}
+	})
+}
+
+// Generate an invite code not used by any other room, must hold roomsMux
+func (h *Hub) newInviteCode() string {
+	const alphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	const length = 6
+
+	for {
+		code := make([]byte, length)
+		for i := range code {
+			code[i] = alphabet[rand.IntN(len(alphabet))]
+		}
+
+		if _, taken := h.FindRoomByCode(string(code)); !taken {
+			return string(code)
+		}
+	}
+}
+
+func (h *Hub) createRoom() *Room {
+	room := newRoom(h, h.roomConfig)
+	room.start(h.Rooms.Add(room))
+	log.Printf("Created %s", room.Name)
+	return room
+}
+
+func (h *Hub) destroyRoom(roomId uint64) {
+	room, exists := h.Rooms.Get(roomId)
+	if !exists {
+		return
+	}
+
+	room.close()
+	h.Rooms.Remove(roomId)
+	log.Printf("Destroyed %s", room.Name)
+}
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+const (
+	viewportHalfWidth  = 960.0
+	viewportHalfHeight = 540.0
+
+	// The radius at which a player sees exactly the base viewport, bigger players see further
+	viewportBaseRadius = 20.0
+)
+
+// The entities a player currently has on screen, so we only send them what
+// they can see and can tell them when something comes into or goes out of view
+type interest struct {
+	players   map[uint64]struct{}
+	spores    map[uint64]struct{}
+	hazards   map[uint64]struct{}
+	powerUps  map[uint64]struct{}
+	snapshots *snapshotHistory
+}
+
+func newInterest() *interest {
+	return &interest{
+		players:   make(map[uint64]struct{}),
+		spores:    make(map[uint64]struct{}),
+		hazards:   make(map[uint64]struct{}),
+		powerUps:  make(map[uint64]struct{}),
+		snapshots: newSnapshotHistory(),
+	}
+}
+
+func viewport(player *objects.Player) (minX, minY, maxX, maxY float64) {
+	scale := max(1, math.Sqrt(player.Radius/viewportBaseRadius))
+	halfWidth := viewportHalfWidth * scale
+	halfHeight := viewportHalfHeight * scale
+	return player.X - halfWidth, player.Y - halfHeight, player.X + halfWidth, player.Y + halfHeight
+}
+
+// Send the viewer a snapshot of the players in their viewport, and the other objects that entered or left it since the last tick
+func (w *World) updateInterest(viewerId uint64, viewer *objects.Player) {
+	client, exists := w.hub.Clients.Get(viewerId)
+	if !exists {
+		return
+	}
+
+	known, exists := w.interests[viewerId]
+	if !exists {
+		return
+	}
+
+	minX, minY, maxX, maxY := viewport(viewer)
+
+	visiblePlayers := make(map[uint64]struct{})
+	playerStates := make(map[uint64]objects.Player)
+	w.objects.Players.QueryRect(minX, minY, maxX, maxY, func(playerId uint64, player *objects.Player) {
+		visiblePlayers[playerId] = struct{}{}
+		playerStates[playerId] = *player
+	})
+
+	visibleSpores := make(map[uint64]struct{})
+	enteredSpores := make(map[uint64]*objects.Spore)
+	w.objects.Spores.QueryRect(minX, minY, maxX, maxY, func(sporeId uint64, spore *objects.Spore) {
+		visibleSpores[sporeId] = struct{}{}
+		_, seen := known.spores[sporeId]
+		_, moving := w.movingSpores[sporeId]
+		if !seen || moving {
+			enteredSpores[sporeId] = spore
+		}
+	})
+
+	visibleHazards := make(map[uint64]struct{})
+	enteredHazards := make(map[uint64]*objects.Hazard)
+	w.objects.Hazards.QueryRect(minX, minY, maxX, maxY, func(hazardId uint64, hazard *objects.Hazard) {
+		visibleHazards[hazardId] = struct{}{}
+		if _, seen := known.hazards[hazardId]; !seen {
+			enteredHazards[hazardId] = hazard
+		}
+	})
+
+	visiblePowerUps := make(map[uint64]struct{})
+	enteredPowerUps := make(map[uint64]*objects.PowerUp)
+	w.objects.PowerUps.QueryRect(minX, minY, maxX, maxY, func(powerUpId uint64, powerUp *objects.PowerUp) {
+		visiblePowerUps[powerUpId] = struct{}{}
+		if _, seen := known.powerUps[powerUpId]; !seen {
+			enteredPowerUps[powerUpId] = powerUp
+		}
+	})
+
+	visibleCells := make([]*packets.CellMessage, 0)
+	w.objects.Cells.QueryRect(minX, minY, maxX, maxY, func(cellId uint64, cell *objects.Cell) {
+		visibleCells = append(visibleCells, packets.NewCell(cellId, cell))
+	})
+
+	leftSpores := leftView(known.spores, visibleSpores)
+	leftHazards := leftView(known.hazards, visibleHazards)
+	leftPowerUps := leftView(known.powerUps, visiblePowerUps)
+
+	known.players = visiblePlayers
+	known.spores = visibleSpores
+	known.hazards = visibleHazards
+	known.powerUps = visiblePowerUps
+
+	if len(leftSpores) > 0 || len(leftHazards) > 0 || len(leftPowerUps) > 0 {
+		client.SocketSendAs(packets.NewViewLeave(leftSpores, leftHazards, leftPowerUps), 0)
+	}
+
+	if len(enteredSpores) > 0 || len(enteredHazards) > 0 || len(enteredPowerUps) > 0 {
+		client.SocketSendAs(packets.NewViewEnter(enteredSpores, enteredHazards, enteredPowerUps), 0)
+	}
+
+	client.SocketSendAs(known.snapshots.next(playerStates, visibleCells), 0)
+}
+
+// Send everyone the newly spawned spores they can see in one batch, so they don't come in one at a time
+func (w *World) notifySporesSpawned(spores map[uint64]*objects.Spore) {
+	w.objects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		w.sendSporesInView(playerId, player, spores)
+	})
+
+	for spectatorId, s := range w.spectators {
+		_, focus := w.spectatorFocus(s)
+		w.sendSporesInView(spectatorId, focus, spores)
+	}
+}
+
+func (w *World) sendSporesInView(viewerId uint64, viewer *objects.Player, spores map[uint64]*objects.Spore) {
+	client, exists := w.hub.Clients.Get(viewerId)
+	if !exists {
+		return
+	}
+
+	known, exists := w.interests[viewerId]
+	if !exists {
+		return
+	}
+
+	minX, minY, maxX, maxY := viewport(viewer)
+	visible := make(map[uint64]*objects.Spore)
+	for sporeId, spore := range spores {
+		if spore.X >= minX && spore.X <= maxX && spore.Y >= minY && spore.Y <= maxY {
+			visible[sporeId] = spore
+			// Already sent, so the next interest update doesn't send it again
+			known.spores[sporeId] = struct{}{}
+		}
+	}
+
+	if len(visible) > 0 {
+		client.SocketSendAs(packets.NewSporesBatch(visible), 0)
+	}
+}
+
+func (w *World) acknowledgeSnapshot(playerId, sequence uint64) {
+	if known, exists := w.interests[playerId]; exists {
+		known.snapshots.acknowledge(sequence)
+	}
+}
+
+// Tell everyone who can see the spore that it has been eaten
+func (w *World) notifySporeConsumed(eaterId, sporeId uint64) {
+	msg := packets.NewSporeConsumed(sporeId)
+	for viewerId, known := range w.interests {
+		if _, visible := known.spores[sporeId]; !visible {
+			continue
+		}
+
+		delete(known.spores, sporeId)
+		w.sendTo(viewerId, eaterId, msg)
+	}
+}
+
+// Tell everyone who can see the hazard that the player ran into it
+func (w *World) notifyHazardBurst(playerId, hazardId uint64) {
+	msg := packets.NewHazardBurst(hazardId, playerId)
+	for viewerId, known := range w.interests {
+		if _, visible := known.hazards[hazardId]; !visible {
+			continue
+		}
+
+		delete(known.hazards, hazardId)
+		w.sendTo(viewerId, playerId, msg)
+	}
+}
+
+// Announce the pickup to the collector and everyone who could see them or the power-up, and take it off their screens
+func (w *World) notifyPowerUpCollected(playerId, powerUpId uint64, msg packets.Msg) {
+	for viewerId, known := range w.interests {
+		_, sawPowerUp := known.powerUps[powerUpId]
+		_, seesCollector := known.players[playerId]
+		delete(known.powerUps, powerUpId)
+
+		if sawPowerUp || seesCollector || viewerId == playerId {
+			w.sendTo(viewerId, 0, msg)
+		}
+	}
+}
+
+// Tell the player and everyone who can see them that one of their effects wore off
+func (w *World) notifyPowerUpExpired(playerId uint64, msg packets.Msg) {
+	for viewerId, known := range w.interests {
+		if _, visible := known.players[playerId]; visible || viewerId == playerId {
+			w.sendTo(viewerId, 0, msg)
+		}
+	}
+}
+
+// Tell everyone who can see the player that they have been eaten, the victim always finds out
+func (w *World) notifyPlayerConsumed(eaterId, victimId uint64) {
+	msg := packets.NewPlayerConsumed(victimId)
+
+	for viewerId, known := range w.interests {
+		if _, visible := known.players[victimId]; !visible {
+			continue
+		}
+
+		delete(known.players, victimId)
+		w.sendTo(viewerId, eaterId, msg)
+	}
+
+	// Goes through the client's state rather than straight to the socket so it can respawn
+	if client, exists := w.hub.Clients.Get(victimId); exists {
+		client.ProcessMessage(eaterId, msg)
+	}
+}
+
+// Take every spore, hazard and power-up off everyone's screen, e.g. before the world is reset
+func (w *World) forgetObjects() {
+	for viewerId, known := range w.interests {
+		if len(known.spores) == 0 && len(known.hazards) == 0 && len(known.powerUps) == 0 {
+			continue
+		}
+
+		sporeIds := leftView(known.spores, nil)
+		hazardIds := leftView(known.hazards, nil)
+		powerUpIds := leftView(known.powerUps, nil)
+		known.spores = make(map[uint64]struct{})
+		known.hazards = make(map[uint64]struct{})
+		known.powerUps = make(map[uint64]struct{})
+		w.sendTo(viewerId, 0, packets.NewViewLeave(sporeIds, hazardIds, powerUpIds))
+	}
+}
+
+// Send the message to every player and spectator in the world, regardless of what they can see
+func (w *World) broadcastToPlayers(msg packets.Msg) {
+	w.objects.Players.ForEach(func(playerId uint64, _ *objects.Player) {
+		w.sendTo(playerId, 0, msg)
+	})
+
+	for spectatorId := range w.spectators {
+		w.sendTo(spectatorId, 0, msg)
+	}
+}
+
+// The joining player isn't in the world yet, so messages for everyone in it need to be sent to them separately
+func (w *World) notifyJoining(playerId uint64, msg packets.Msg) {
+	w.sendTo(playerId, 0, msg)
+}
+
+func (w *World) sendTo(clientId, senderId uint64, msg packets.Msg) {
+	if client, exists := w.hub.Clients.Get(clientId); exists {
+		client.SocketSendAs(msg, senderId)
+	}
+}
+
+func leftView(before, after map[uint64]struct{}) []uint64 {
+	left := make([]uint64, 0)
+	for id := range before {
+		if _, stillVisible := after[id]; !stillVisible {
+			left = append(left, id)
+		}
+	}
+	return left
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"time"
+)
+
+const (
+	// How long it takes a player to mostly settle into a new direction
+	DefaultInertia = 200 * time.Millisecond
+
+	// How strongly speed drops off with size, and the slowest anyone gets
+	speedFalloff   = 0.4
+	minPlayerSpeed = 40.0
+
+	// Players steering towards a point start slowing down this far from it, and stop once they're this close
+	targetSlowdownDistance = 150.0
+	targetStopDistance     = 5.0
+)
+
+// Bigger bodies are slower, a body at the starting size moves at exactly the base speed
+func topSpeed(baseSpeed, radius float64) float64 {
+	return max(minPlayerSpeed, baseSpeed*math.Pow(initialPlayerRadius/radius, speedFalloff))
+}
+
+// Where a body at x, y belonging to the player wants to go, and how fast
+func steer(player *objects.Player, x, y, radius float64) (direction, speed float64) {
+	speed = topSpeed(player.Speed, radius)
+	if player.HasEffect(objects.PowerUpSpeed) {
+		speed *= speedBoostFactor
+	}
+	if !player.HasTarget {
+		return player.Direction, speed
+	}
+
+	distance := math.Hypot(player.TargetX-x, player.TargetY-y)
+	if distance < targetStopDistance {
+		return player.Direction, 0
+	}
+
+	direction = math.Atan2(player.TargetY-y, player.TargetX-x)
+	return direction, speed * min(1, distance/targetSlowdownDistance)
+}
+
+// Ease the player's velocity towards the speed and direction they want to go in, then move them along it
+func (w *World) movePlayer(player *objects.Player, delta float64) {
+	direction, speed := steer(player, player.X, player.Y, player.Radius)
+	// Keep facing the target, splits and ejects go that way
+	player.Direction = direction
+	targetX := speed * math.Cos(direction)
+	targetY := speed * math.Sin(direction)
+
+	blend := 1.0
+	if w.inertia > 0 {
+		blend = min(1, delta/w.inertia.Seconds())
+	}
+	player.VelocityX += (targetX - player.VelocityX) * blend
+	player.VelocityY += (targetY - player.VelocityY) * blend
+
+	x := player.X + player.VelocityX*delta
+	y := player.Y + player.VelocityY*delta
+	player.X, player.Y = w.gameMap.Place(x, y, player.Radius)
+}
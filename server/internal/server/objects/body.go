@@ -0,0 +1,51 @@
+package objects
+
+import "time"
+
+// Something a player eats with, either their main body or one of the cells they split off
+type Body interface {
+	Position() (float64, float64)
+	Size() float64
+
+	// Gain (or lose) mass
+	Grow(massDiff float64)
+}
+
+func (p *Player) Position() (float64, float64) {
+	return p.X, p.Y
+}
+
+func (p *Player) Size() float64 {
+	return p.Radius
+}
+
+func (p *Player) Grow(massDiff float64) {
+	p.Radius = NextRadius(p.Radius, massDiff)
+}
+
+// The combined mass of the main body and every split off cell
+func (p *Player) Mass() float64 {
+	mass := RadToMass(p.Radius)
+	for _, cell := range p.Cells {
+		mass += RadToMass(cell.Radius)
+	}
+	return mass
+}
+
+// Whether the power-up effect is active right now
+func (p *Player) HasEffect(kind PowerUpKind) bool {
+	expiresAt, exists := p.Effects[kind]
+	return exists && time.Now().Before(expiresAt)
+}
+
+func (c *Cell) Position() (float64, float64) {
+	return c.X, c.Y
+}
+
+func (c *Cell) Size() float64 {
+	return c.Radius
+}
+
+func (c *Cell) Grow(massDiff float64) {
+	c.Radius = NextRadius(c.Radius, massDiff)
+}
@@ -0,0 +1,81 @@
+package objects
+
+import "math"
+
+// The rectangle everything in a world has to stay inside of
+type Bounds struct {
+	MinX float64
+	MinY float64
+	MaxX float64
+	MaxY float64
+}
+
+// A width by height rectangle centered on the origin
+func NewBounds(width, height float64) Bounds {
+	return Bounds{
+		MinX: -width / 2,
+		MinY: -height / 2,
+		MaxX: width / 2,
+		MaxY: height / 2,
+	}
+}
+
+// The closest position to x, y where a circle of the given radius is fully inside the bounds
+func (b Bounds) Clamp(x, y, radius float64) (float64, float64) {
+	return clampAxis(x, radius, b.MinX, b.MaxX), clampAxis(y, radius, b.MinY, b.MaxY)
+}
+
+func clampAxis(value, radius, low, high float64) float64 {
+	// Too big to fit, keep it in the middle
+	if high-low <= 2*radius {
+		return (low + high) / 2
+	}
+
+	return max(low+radius, min(value, high-radius))
+}
+
+func (b Bounds) Width() float64 {
+	return b.MaxX - b.MinX
+}
+
+func (b Bounds) Height() float64 {
+	return b.MaxY - b.MinY
+}
+
+func (b Bounds) Area() float64 {
+	return b.Width() * b.Height()
+}
+
+// Whether a circle at x, y with the given radius touches the rectangle at all
+func (b Bounds) Overlaps(x, y, radius float64) bool {
+	closestX, closestY := max(b.MinX, min(x, b.MaxX)), max(b.MinY, min(y, b.MaxY))
+	return math.Hypot(x-closestX, y-closestY) < radius
+}
+
+// The closest position to x, y where a circle of the given radius doesn't overlap the rectangle
+func (b Bounds) PushOut(x, y, radius float64) (float64, float64) {
+	closestX, closestY := max(b.MinX, min(x, b.MaxX)), max(b.MinY, min(y, b.MaxY))
+	dx, dy := x-closestX, y-closestY
+	distance := math.Hypot(dx, dy)
+	if distance >= radius {
+		return x, y
+	}
+
+	if distance > 0 {
+		return closestX + dx/distance*radius, closestY + dy/distance*radius
+	}
+
+	// The center is inside the rectangle, leave through the nearest edge
+	toLeft, toRight := x-b.MinX, b.MaxX-x
+	toTop, toBottom := y-b.MinY, b.MaxY-y
+	switch min(toLeft, toRight, toTop, toBottom) {
+	case toLeft:
+		return b.MinX - radius, y
+	case toRight:
+		return b.MaxX + radius, y
+	case toTop:
+		return x, b.MinY - radius
+	default:
+		return x, b.MaxY + radius
+	}
+}
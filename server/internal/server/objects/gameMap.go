@@ -0,0 +1,115 @@
+package objects
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+)
+
+// A part of the map that spores spawn in, the denser regions get more of them
+type SporeRegion struct {
+	Area Bounds `json:"area"`
+
+	// Spores per 1000 by 1000 square
+	Density float64 `json:"density"`
+}
+
+// The layout of a world, loaded from a JSON file. All coordinates are in world
+// space, where the map is centered on the origin.
+type Map struct {
+	Name   string  `json:"name"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+
+	// Solid rectangles nobody can move through
+	Obstacles []Bounds `json:"obstacles"`
+
+	// Spores spawn anywhere if there are no regions, and players anywhere if there are no spawn zones
+	SporeRegions []SporeRegion `json:"sporeRegions"`
+	SpawnZones   []Bounds      `json:"spawnZones"`
+}
+
+// A map with nothing in it, for worlds that aren't loaded from a file
+func NewEmptyMap(width, height float64) *Map {
+	return &Map{
+		Name:   "empty",
+		Width:  width,
+		Height: height,
+	}
+}
+
+func LoadMap(path string) (*Map, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading map file: %w", err)
+	}
+
+	m := &Map{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("error parsing map file: %w", err)
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid map %s: %w", path, err)
+	}
+
+	return m, nil
+}
+
+func (m *Map) validate() error {
+	if m.Width <= 0 || m.Height <= 0 {
+		return errors.New("width and height must be positive")
+	}
+
+	areas := append(append([]Bounds{}, m.Obstacles...), m.SpawnZones...)
+	for _, region := range m.SporeRegions {
+		if region.Density < 0 {
+			return errors.New("spore region density can't be negative")
+		}
+		areas = append(areas, region.Area)
+	}
+
+	for _, area := range areas {
+		if area.Width() <= 0 || area.Height() <= 0 {
+			return fmt.Errorf("area %v is empty", area)
+		}
+	}
+
+	return nil
+}
+
+func (m *Map) Bounds() Bounds {
+	return NewBounds(m.Width, m.Height)
+}
+
+// Whether a circle at x, y with the given radius would overlap an obstacle
+func (m *Map) Blocked(x, y, radius float64) bool {
+	for _, obstacle := range m.Obstacles {
+		if obstacle.Overlaps(x, y, radius) {
+			return true
+		}
+	}
+	return false
+}
+
+// The closest position to x, y where a circle of the given radius is inside the map and clear of the obstacles
+func (m *Map) Place(x, y, radius float64) (float64, float64) {
+	bounds := m.Bounds()
+	x, y = bounds.Clamp(x, y, radius)
+	for _, obstacle := range m.Obstacles {
+		x, y = obstacle.PushOut(x, y, radius)
+	}
+
+	// Getting pushed out of an obstacle can't be allowed to push it out of the world
+	return bounds.Clamp(x, y, radius)
+}
+
+// Where a player should spawn, a random spawn zone if there are any
+func (m *Map) SpawnArea() Bounds {
+	if len(m.SpawnZones) == 0 {
+		return m.Bounds()
+	}
+	return m.SpawnZones[rand.IntN(len(m.SpawnZones))]
+}
@@ -8,16 +8,170 @@ type Player struct {
 	Y         float64
 	Radius    float64
 	Direction float64
+	// The speed at the starting size, bigger players are slower
 	Speed     float64
+	VelocityX float64
+	VelocityY float64
+
+	// Steering towards a point rather than in a direction
+	HasTarget bool
+	TargetX   float64
+	TargetY   float64
+
 	BestScore int64
 	DbId      int64
 	Color			uint32
+	Team      uint32
+
+	// The pieces split off from the main body, keyed by their ID in the world's cell collection
+	Cells map[uint64]*Cell
+
+	LastEjectAt time.Time
+
+	// When each power-up effect the player has wears off
+	Effects map[PowerUpKind]time.Time
+
+	// Nobody can eat a player who just spawned until the protection runs out
+	SpawnProtected      bool
+	SpawnProtectedUntil time.Time
+
+	// How the current life is going, started over every time the player spawns
+	SpawnedAt   time.Time
+	PeakMass    float64
+	Kills       uint32
+	SporesEaten uint32
+	KillerId    uint64
+	KillerName  string
 }
 
+// A piece of a player's mass that was split off. It follows its owner around
+// and merges back into the main body once MergeAt has passed.
+type Cell struct {
+	OwnerId uint64
+	Owner   *Player
+	X       float64
+	Y       float64
+	Radius  float64
+
+	// What's left of the push the cell was launched with
+	VelocityX float64
+	VelocityY float64
+
+	MergeAt time.Time
+}
+
+// Matches the SporeKind enum clients are sent
+type SporeKind uint32
+
+const (
+	SporeCommon SporeKind = iota
+	SporeGolden
+	SporePoison
+)
+
 type Spore struct {
 	X         float64
 	Y         float64
 	Radius    float64
+	Kind      SporeKind
 	DroppedBy *Player
 	DroppedAt time.Time
-}
\ No newline at end of file
+
+	// Only ejected spores move, they slow down until they come to rest
+	VelocityX float64
+	VelocityY float64
+}
+
+// A static obstacle, small players can hide behind it but it bursts big ones that run into it
+type Hazard struct {
+	X      float64
+	Y      float64
+	Radius float64
+}
+
+// Matches the PowerUpKind enum clients are sent
+type PowerUpKind uint32
+
+const (
+	PowerUpSpeed PowerUpKind = iota + 1
+	PowerUpShield
+	PowerUpMagnet
+)
+
+// A pickup giving the player who collects it a temporary effect
+type PowerUp struct {
+	X      float64
+	Y      float64
+	Radius float64
+	Kind   PowerUpKind
+}
+
+const (
+	playerCellSize  = 250.0
+	sporeCellSize   = 100.0
+	hazardCellSize  = 250.0
+	powerUpCellSize = 100.0
+
+	// Grid cell size for the index of split off cells, not to be confused with the cells themselves
+	splitCellSize = 250.0
+)
+
+func NewPlayerCollection() *SharedCollection[*Player] {
+	return NewSpatialCollection(playerCellSize, getPlayerPosition, getPlayerRadius)
+}
+
+func NewSporeCollection() *SharedCollection[*Spore] {
+	return NewSpatialCollection(sporeCellSize, getSporePosition, getSporeRadius)
+}
+
+func NewHazardCollection() *SharedCollection[*Hazard] {
+	return NewSpatialCollection(hazardCellSize, getHazardPosition, getHazardRadius)
+}
+
+func NewPowerUpCollection() *SharedCollection[*PowerUp] {
+	return NewSpatialCollection(powerUpCellSize, getPowerUpPosition, getPowerUpRadius)
+}
+
+func NewCellCollection() *SharedCollection[*Cell] {
+	return NewSpatialCollection(splitCellSize, getCellPosition, getCellRadius)
+}
+
+var getPlayerPosition = func(p *Player) (float64, float64) {
+	return p.X, p.Y
+}
+
+var getPlayerRadius = func(p *Player) float64 {
+	return p.Radius
+}
+
+var getSporePosition = func(s *Spore) (float64, float64) {
+	return s.X, s.Y
+}
+
+var getSporeRadius = func(s *Spore) float64 {
+	return s.Radius
+}
+
+var getCellPosition = func(c *Cell) (float64, float64) {
+	return c.X, c.Y
+}
+
+var getCellRadius = func(c *Cell) float64 {
+	return c.Radius
+}
+
+var getHazardPosition = func(h *Hazard) (float64, float64) {
+	return h.X, h.Y
+}
+
+var getHazardRadius = func(h *Hazard) float64 {
+	return h.Radius
+}
+
+var getPowerUpPosition = func(p *PowerUp) (float64, float64) {
+	return p.X, p.Y
+}
+
+var getPowerUpRadius = func(p *PowerUp) float64 {
+	return p.Radius
+}
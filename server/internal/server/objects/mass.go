@@ -0,0 +1,16 @@
+package objects
+
+import "math"
+
+func MassToRad(mass float64) float64 {
+	return math.Sqrt(mass / math.Pi)
+}
+
+func RadToMass(radius float64) float64 {
+	return math.Pi * radius * radius
+}
+
+// Get the radius an object of the given radius would have after gaining (or losing) massDiff
+func NextRadius(radius, massDiff float64) float64 {
+	return MassToRad(RadToMass(radius) + massDiff)
+}
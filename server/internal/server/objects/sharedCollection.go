@@ -6,6 +6,7 @@ type SharedCollection[T any] struct {
 	objectsMap map[uint64]T
 	nextId     uint64
 	mapMux     sync.Mutex
+	index      *spatialIndex[T]
 }
 
 func NewSharedCollection[T any](capacity ...int) *SharedCollection[T] {
@@ -23,6 +24,13 @@ func NewSharedCollection[T any](capacity ...int) *SharedCollection[T] {
 	}
 }
 
+// Create a collection that also keeps its objects in a spatial grid, enabling radius and rectangle queries
+func NewSpatialCollection[T any](cellSize float64, getPosition func(T) (float64, float64), getRadius func(T) float64, capacity ...int) *SharedCollection[T] {
+	s := NewSharedCollection[T](capacity...)
+	s.index = newSpatialIndex(cellSize, getPosition, getRadius)
+	return s
+}
+
 func (s *SharedCollection[T]) Add(obj T, id ...uint64) uint64 {
 	s.mapMux.Lock()
 	defer s.mapMux.Unlock()
@@ -33,6 +41,9 @@ func (s *SharedCollection[T]) Add(obj T, id ...uint64) uint64 {
 	}
 
 	s.objectsMap[thisId] = obj
+	if s.index != nil {
+		s.index.insert(thisId, obj)
+	}
 	s.nextId++
 	return thisId
 }
@@ -42,6 +53,51 @@ func (s *SharedCollection[T]) Remove(id uint64) {
 	defer s.mapMux.Unlock()
 
 	delete(s.objectsMap, id)
+	if s.index != nil {
+		s.index.remove(id)
+	}
+}
+
+// Refresh the spatial index after the object with the given ID moved or changed size
+func (s *SharedCollection[T]) Reindex(id uint64) {
+	s.mapMux.Lock()
+	defer s.mapMux.Unlock()
+
+	obj, found := s.objectsMap[id]
+	if !found || s.index == nil {
+		return
+	}
+	s.index.insert(id, obj)
+}
+
+// Call the callback for every object overlapping the circle, only works on spatial collections
+func (s *SharedCollection[T]) QueryRadius(x, y, radius float64, callback func(uint64, T)) {
+	if s.index == nil {
+		return
+	}
+
+	s.mapMux.Lock()
+	results := s.index.queryRadius(x, y, radius)
+	s.mapMux.Unlock()
+
+	for id, obj := range results {
+		callback(id, obj)
+	}
+}
+
+// Call the callback for every object overlapping the rectangle, only works on spatial collections
+func (s *SharedCollection[T]) QueryRect(minX, minY, maxX, maxY float64, callback func(uint64, T)) {
+	if s.index == nil {
+		return
+	}
+
+	s.mapMux.Lock()
+	results := s.index.queryRect(minX, minY, maxX, maxY)
+	s.mapMux.Unlock()
+
+	for id, obj := range results {
+		callback(id, obj)
+	}
 }
 
 func (s *SharedCollection[T]) ForEach(callback func(uint64, T)) {
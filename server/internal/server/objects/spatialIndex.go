@@ -0,0 +1,153 @@
+package objects
+
+import "math"
+
+type gridCell struct {
+	x int
+	y int
+}
+
+// A uniform grid bucketing objects by the cell their centre falls in. It is not
+// safe for concurrent use on its own, the owning SharedCollection guards it.
+type spatialIndex[T any] struct {
+	cellSize    float64
+	cells       map[gridCell]map[uint64]T
+	objectCells map[uint64]gridCell
+	radii       map[uint64]float64
+
+	// The largest radius currently indexed, queries widen their search by it so
+	// that big objects centred in a neighbouring cell are not missed
+	maxRadius float64
+
+	getPosition func(T) (float64, float64)
+	getRadius   func(T) float64
+}
+
+func newSpatialIndex[T any](cellSize float64, getPosition func(T) (float64, float64), getRadius func(T) float64) *spatialIndex[T] {
+	return &spatialIndex[T]{
+		cellSize:    cellSize,
+		cells:       make(map[gridCell]map[uint64]T),
+		objectCells: make(map[uint64]gridCell),
+		radii:       make(map[uint64]float64),
+		getPosition: getPosition,
+		getRadius:   getRadius,
+	}
+}
+
+func (g *spatialIndex[T]) cellAt(x, y float64) gridCell {
+	return gridCell{
+		x: int(math.Floor(x / g.cellSize)),
+		y: int(math.Floor(y / g.cellSize)),
+	}
+}
+
+func (g *spatialIndex[T]) insert(id uint64, obj T) {
+	g.remove(id)
+
+	x, y := g.getPosition(obj)
+	radius := g.getRadius(obj)
+	g.radii[id] = radius
+	g.maxRadius = max(g.maxRadius, radius)
+
+	cell := g.cellAt(x, y)
+	bucket, exists := g.cells[cell]
+	if !exists {
+		bucket = make(map[uint64]T)
+		g.cells[cell] = bucket
+	}
+	bucket[id] = obj
+	g.objectCells[id] = cell
+}
+
+func (g *spatialIndex[T]) remove(id uint64) {
+	cell, exists := g.objectCells[id]
+	if !exists {
+		return
+	}
+
+	bucket := g.cells[cell]
+	delete(bucket, id)
+	if len(bucket) == 0 {
+		delete(g.cells, cell)
+	}
+	delete(g.objectCells, id)
+
+	radius := g.radii[id]
+	delete(g.radii, id)
+	if radius >= g.maxRadius {
+		g.recomputeMaxRadius()
+	}
+}
+
+// Only needed when the biggest object is removed or reindexed. That can happen every
+// tick for the biggest player, but scanning the radii is still cheaper than letting
+// every query search a wider area than it needs to for good.
+func (g *spatialIndex[T]) recomputeMaxRadius() {
+	g.maxRadius = 0
+	for _, radius := range g.radii {
+		g.maxRadius = max(g.maxRadius, radius)
+	}
+}
+
+// Collect every object whose circle intersects the given rectangle
+func (g *spatialIndex[T]) queryRect(minX, minY, maxX, maxY float64) map[uint64]T {
+	results := make(map[uint64]T)
+	g.forEachCandidate(minX, minY, maxX, maxY, func(id uint64, obj T) {
+		x, y := g.getPosition(obj)
+		nearestX := max(minX, min(x, maxX))
+		nearestY := max(minY, min(y, maxY))
+		if distanceSq(x, y, nearestX, nearestY) <= square(g.getRadius(obj)) {
+			results[id] = obj
+		}
+	})
+	return results
+}
+
+// Collect every object whose circle intersects the given circle
+func (g *spatialIndex[T]) queryRadius(x, y, radius float64) map[uint64]T {
+	results := make(map[uint64]T)
+	g.forEachCandidate(x-radius, y-radius, x+radius, y+radius, func(id uint64, obj T) {
+		objX, objY := g.getPosition(obj)
+		if distanceSq(x, y, objX, objY) < square(radius+g.getRadius(obj)) {
+			results[id] = obj
+		}
+	})
+	return results
+}
+
+func (g *spatialIndex[T]) forEachCandidate(minX, minY, maxX, maxY float64, callback func(uint64, T)) {
+	minCell := g.cellAt(minX-g.maxRadius, minY-g.maxRadius)
+	maxCell := g.cellAt(maxX+g.maxRadius, maxY+g.maxRadius)
+
+	// Scanning the buckets directly is cheaper than walking a huge, mostly empty area
+	cellsInArea := (maxCell.x - minCell.x + 1) * (maxCell.y - minCell.y + 1)
+	if cellsInArea > len(g.cells) {
+		for cell, bucket := range g.cells {
+			if cell.x < minCell.x || cell.x > maxCell.x || cell.y < minCell.y || cell.y > maxCell.y {
+				continue
+			}
+			for id, obj := range bucket {
+				callback(id, obj)
+			}
+		}
+		return
+	}
+
+	for cx := minCell.x; cx <= maxCell.x; cx++ {
+		for cy := minCell.y; cy <= maxCell.y; cy++ {
+			for id, obj := range g.cells[gridCell{cx, cy}] {
+				callback(id, obj)
+			}
+		}
+	}
+}
+
+func distanceSq(x1, y1, x2, y2 float64) float64 {
+	dx := x1 - x2
+	dy := y1 - y2
+	return dx*dx + dy*dy
+}
+
+func square(x float64) float64 {
+	return x * x
+}
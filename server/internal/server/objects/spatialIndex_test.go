@@ -0,0 +1,249 @@
+package objects
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+type testCircle struct {
+	x, y, radius float64
+}
+
+func newTestIndex() *spatialIndex[*testCircle] {
+	return newSpatialIndex(100,
+		func(c *testCircle) (float64, float64) { return c.x, c.y },
+		func(c *testCircle) float64 { return c.radius },
+	)
+}
+
+func sortedIds[T any](results map[uint64]T) []uint64 {
+	return slices.Sorted(maps.Keys(results))
+}
+
+func TestSpatialIndexQueryRadius(t *testing.T) {
+	tests := []struct {
+		name    string
+		circles map[uint64]*testCircle
+		x, y, r float64
+		want    []uint64
+	}{
+		{
+			name:    "empty",
+			circles: map[uint64]*testCircle{},
+			x:       0, y: 0, r: 50,
+			want: []uint64{},
+		},
+		{
+			name: "overlapping and not",
+			circles: map[uint64]*testCircle{
+				1: {x: 10, y: 0, radius: 5},
+				2: {x: 300, y: 0, radius: 5},
+			},
+			x: 0, y: 0, r: 10,
+			want: []uint64{1},
+		},
+		{
+			name: "touching doesn't count",
+			circles: map[uint64]*testCircle{
+				1: {x: 20, y: 0, radius: 10},
+			},
+			x: 0, y: 0, r: 10,
+			want: []uint64{},
+		},
+		{
+			name: "big circle centred cells away",
+			circles: map[uint64]*testCircle{
+				1: {x: 450, y: 0, radius: 400},
+			},
+			x: 0, y: 0, r: 60,
+			want: []uint64{1},
+		},
+		{
+			name: "negative coordinates",
+			circles: map[uint64]*testCircle{
+				1: {x: -150, y: -150, radius: 10},
+				2: {x: 150, y: 150, radius: 10},
+			},
+			x: -140, y: -140, r: 5,
+			want: []uint64{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := newTestIndex()
+			for id, circle := range tt.circles {
+				index.insert(id, circle)
+			}
+
+			got := sortedIds(index.queryRadius(tt.x, tt.y, tt.r))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("queryRadius(%g, %g, %g) = %v, want %v", tt.x, tt.y, tt.r, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpatialIndexQueryRect(t *testing.T) {
+	circles := map[uint64]*testCircle{
+		1: {x: 50, y: 50, radius: 5},
+		2: {x: 210, y: 50, radius: 15},
+		3: {x: 230, y: 50, radius: 5},
+		4: {x: -500, y: -500, radius: 5},
+	}
+
+	tests := []struct {
+		name                   string
+		minX, minY, maxX, maxY float64
+		want                   []uint64
+	}{
+		{name: "inside", minX: 0, minY: 0, maxX: 100, maxY: 100, want: []uint64{1}},
+		{name: "sticking in over the edge", minX: 0, minY: 0, maxX: 200, maxY: 100, want: []uint64{1, 2}},
+		{name: "nothing there", minX: 1000, minY: 1000, maxX: 1100, maxY: 1100, want: []uint64{}},
+		{name: "everything", minX: -1000, minY: -1000, maxX: 1000, maxY: 1000, want: []uint64{1, 2, 3, 4}},
+	}
+
+	index := newTestIndex()
+	for id, circle := range circles {
+		index.insert(id, circle)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sortedIds(index.queryRect(tt.minX, tt.minY, tt.maxX, tt.maxY))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("queryRect(%g, %g, %g, %g) = %v, want %v", tt.minX, tt.minY, tt.maxX, tt.maxY, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpatialIndexMovesAndRemovals(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(index *spatialIndex[*testCircle], circles map[uint64]*testCircle)
+		want   []uint64
+	}{
+		{
+			name:   "unchanged",
+			update: func(*spatialIndex[*testCircle], map[uint64]*testCircle) {},
+			want:   []uint64{1, 2},
+		},
+		{
+			name: "moved out of range",
+			update: func(index *spatialIndex[*testCircle], circles map[uint64]*testCircle) {
+				circles[1].x = 900
+				index.insert(1, circles[1])
+			},
+			want: []uint64{2},
+		},
+		{
+			name: "moved into range",
+			update: func(index *spatialIndex[*testCircle], circles map[uint64]*testCircle) {
+				circles[3].x, circles[3].y = 0, 0
+				index.insert(3, circles[3])
+			},
+			want: []uint64{1, 2, 3},
+		},
+		{
+			name: "removed",
+			update: func(index *spatialIndex[*testCircle], _ map[uint64]*testCircle) {
+				index.remove(2)
+			},
+			want: []uint64{1},
+		},
+		{
+			name: "removed twice",
+			update: func(index *spatialIndex[*testCircle], _ map[uint64]*testCircle) {
+				index.remove(2)
+				index.remove(2)
+			},
+			want: []uint64{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			circles := map[uint64]*testCircle{
+				1: {x: 10, y: 10, radius: 5},
+				2: {x: -10, y: -10, radius: 5},
+				3: {x: 700, y: 700, radius: 5},
+			}
+
+			index := newTestIndex()
+			for id, circle := range circles {
+				index.insert(id, circle)
+			}
+
+			tt.update(index, circles)
+			got := sortedIds(index.queryRadius(0, 0, 50))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("queryRadius after update = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpatialIndexMaxRadius(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(index *spatialIndex[*testCircle], circles map[uint64]*testCircle)
+		want   float64
+	}{
+		{
+			name:   "largest indexed",
+			update: func(*spatialIndex[*testCircle], map[uint64]*testCircle) {},
+			want:   500,
+		},
+		{
+			name: "largest removed",
+			update: func(index *spatialIndex[*testCircle], _ map[uint64]*testCircle) {
+				index.remove(2)
+			},
+			want: 20,
+		},
+		{
+			name: "largest shrunk",
+			update: func(index *spatialIndex[*testCircle], circles map[uint64]*testCircle) {
+				circles[2].radius = 10
+				index.insert(2, circles[2])
+			},
+			want: 20,
+		},
+		{
+			name: "smaller removed",
+			update: func(index *spatialIndex[*testCircle], _ map[uint64]*testCircle) {
+				index.remove(1)
+			},
+			want: 500,
+		},
+		{
+			name: "everything removed",
+			update: func(index *spatialIndex[*testCircle], _ map[uint64]*testCircle) {
+				index.remove(1)
+				index.remove(2)
+			},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			circles := map[uint64]*testCircle{
+				1: {x: 0, y: 0, radius: 20},
+				2: {x: 0, y: 0, radius: 500},
+			}
+
+			index := newTestIndex()
+			for id, circle := range circles {
+				index.insert(id, circle)
+			}
+
+			tt.update(index, circles)
+			if index.maxRadius != tt.want {
+				t.Errorf("maxRadius = %g, want %g", index.maxRadius, tt.want)
+			}
+		})
+	}
+}
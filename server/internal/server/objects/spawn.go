@@ -1,68 +1,73 @@
 package objects
 
-import "math/rand/v2"
+import (
+	"math/rand/v2"
+)
 
-func SpawnCoords(radius float64, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore]) (float64, float64) {
-	bound := 3000.0
+// How far from the edge of any bigger player a newcomer is placed, if there's room
+const SpawnSafeDistance = 400.0
 
-	const maxTries = 25
+// A random spot inside the area of the map that isn't blocked and doesn't overlap anything to avoid, if one can be found
+func SpawnCoords(radius float64, area Bounds, gameMap *Map, playersToAvoid *SharedCollection[*Player], sporesToAvoid *SharedCollection[*Spore], hazardsToAvoid *SharedCollection[*Hazard]) (float64, float64) {
+	const maxTries = 100
 
-	tries := 0
-
-	for {
-		x := bound * (2 * rand.Float64() - 1)
-		y := bound * (2 * rand.Float64() - 1)
-
-		if !isTooClose(x, y, radius, playersToAvoid, getPlayerPosition, getPlayerRadius) && !isTooClose(x, y, radius, sporesToAvoid, getSporePosition, getSporeRadius) {
+	var x, y float64
+	for range maxTries {
+		x, y = randomCoords(radius, area, gameMap)
+		if !gameMap.Blocked(x, y, radius) && !isTooClose(x, y, radius, playersToAvoid) && !isTooClose(x, y, radius, sporesToAvoid) && !isTooClose(x, y, radius, hazardsToAvoid) {
 			return x, y
 		}
+	}
+
+	// The world is too crowded, overlapping is better than never spawning. Obstacles are
+	// solid though, so at least get it out of those.
+	return gameMap.Place(x, y, radius)
+}
+
+// Like SpawnCoords, but also out of reach of any player or cell bigger than a newcomer of the given radius
+func SafeSpawnCoords(radius float64, area Bounds, gameMap *Map, players *SharedCollection[*Player], cells *SharedCollection[*Cell], hazardsToAvoid *SharedCollection[*Hazard]) (float64, float64) {
+	const maxTries = 100
 
-		tries++
-		if tries >= maxTries {
-			bound *= 2
-			tries = 0
+	for range maxTries {
+		x, y := randomCoords(radius, area, gameMap)
+		if !gameMap.Blocked(x, y, radius) && !isTooClose(x, y, radius, players) && !isTooClose(x, y, radius, hazardsToAvoid) && !InDanger(x, y, radius, players, cells) {
+			return x, y
 		}
 	}
-}
 
-var getPlayerPosition = func(p *Player) (float64, float64) {
-	return p.X, p.Y
+	// Nowhere is safe, settle for not spawning on top of anything
+	return SpawnCoords(radius, area, gameMap, players, nil, hazardsToAvoid)
 }
 
-var getPlayerRadius = func(p *Player) float64 {
-	return p.Radius
-}
+// Whether a body of the given radius at x, y would be within SpawnSafeDistance of a bigger player or cell
+func InDanger(x, y, radius float64, players *SharedCollection[*Player], cells *SharedCollection[*Cell]) bool {
+	inDanger := false
+	players.QueryRadius(x, y, radius+SpawnSafeDistance, func(_ uint64, player *Player) {
+		inDanger = inDanger || player.Radius > radius
+	})
+	cells.QueryRadius(x, y, radius+SpawnSafeDistance, func(_ uint64, cell *Cell) {
+		inDanger = inDanger || cell.Radius > radius
+	})
 
-var getSporePosition = func(s *Spore) (float64, float64) {
-	return s.X, s.Y
+	return inDanger
 }
 
-var getSporeRadius = func(s *Spore) float64 {
-	return s.Radius
+func randomCoords(radius float64, area Bounds, gameMap *Map) (float64, float64) {
+	x := area.MinX + rand.Float64()*area.Width()
+	y := area.MinY + rand.Float64()*area.Height()
+	x, y = area.Clamp(x, y, radius)
+	return gameMap.Bounds().Clamp(x, y, radius)
 }
 
-func isTooClose[T any](x, y, radius float64, objects *SharedCollection[T], getPosition func(T) (float64, float64), getRadius func(T) float64) bool {
+func isTooClose[T any](x, y, radius float64, objects *SharedCollection[T]) bool {
 	if objects == nil {
 		return false
 	}
 
 	tooClose := false
-	objects.ForEach(func(_ uint64, obj T) {
-		if tooClose {
-			return
-		}
-
-		objX, objY := getPosition(obj)
-		objRadius := getRadius(obj)
-		xDist := objX - x
-		yDist := objY - y
-		dstSq := xDist*xDist + yDist*yDist
-
-		if dstSq < (radius+objRadius)*(radius+objRadius) {
-			tooClose = true
-			return
-		}
+	objects.QueryRadius(x, y, radius, func(_ uint64, _ T) {
+		tooClose = true
 	})
 
 	return tooClose
-}
\ No newline at end of file
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"math"
+	"math/rand/v2"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"time"
+)
+
+const (
+	MaxPowerUps = 10
+
+	powerUpRadius         = 15.0
+	powerUpReplenishBatch = 1
+
+	speedBoostFactor = 1.5
+
+	// Magnets pull in spores this far past the edge of the player
+	magnetRange     = 250.0
+	magnetPullSpeed = 300.0
+)
+
+var powerUpDurations = map[objects.PowerUpKind]time.Duration{
+	objects.PowerUpSpeed:  8 * time.Second,
+	objects.PowerUpShield: 6 * time.Second,
+	objects.PowerUpMagnet: 10 * time.Second,
+}
+
+// Scatter power-ups among the spores before the simulation starts
+func (w *World) seedPowerUps() {
+	w.logger.Println("Placing power-ups...")
+	for i := 0; i < MaxPowerUps; i++ {
+		w.objects.PowerUps.Add(w.newPowerUp())
+	}
+}
+
+func (w *World) replenishPowerUps(limit int) {
+	diff := MaxPowerUps - w.objects.PowerUps.Len()
+	if diff <= 0 {
+		return
+	}
+
+	w.logger.Printf("Replenishing %d power-ups", min(diff, limit))
+	for i := 0; i < min(diff, limit); i++ {
+		w.objects.PowerUps.Add(w.newPowerUp())
+	}
+}
+
+func (w *World) newPowerUp() *objects.PowerUp {
+	x, y := objects.SpawnCoords(powerUpRadius, w.bounds, w.gameMap, w.objects.Players, w.objects.Spores, w.objects.Hazards)
+	return &objects.PowerUp{
+		X:      x,
+		Y:      y,
+		Radius: powerUpRadius,
+		Kind:   objects.PowerUpKind(rand.IntN(len(powerUpDurations)) + 1),
+	}
+}
+
+func (w *World) collectPowerUps(playerId uint64, player *objects.Player, body objects.Body) {
+	x, y := body.Position()
+	w.objects.PowerUps.QueryRadius(x, y, body.Size(), func(powerUpId uint64, powerUp *objects.PowerUp) {
+		// Another body of the same player might have picked it up already
+		if _, exists := w.objects.PowerUps.Get(powerUpId); !exists {
+			return
+		}
+
+		duration := powerUpDurations[powerUp.Kind]
+		if player.Effects == nil {
+			player.Effects = make(map[objects.PowerUpKind]time.Time)
+		}
+		player.Effects[powerUp.Kind] = time.Now().Add(duration)
+
+		w.objects.PowerUps.Remove(powerUpId)
+		w.notifyPowerUpCollected(playerId, powerUpId, packets.NewPowerUpCollected(powerUpId, playerId, powerUp.Kind, duration))
+	})
+}
+
+// Drop the effects that wore off and let everyone who can see the player know
+func (w *World) expireEffects(playerId uint64, player *objects.Player) {
+	for kind := range player.Effects {
+		if player.HasEffect(kind) {
+			continue
+		}
+
+		delete(player.Effects, kind)
+		w.notifyPowerUpExpired(playerId, packets.NewPowerUpExpired(playerId, kind))
+	}
+}
+
+// Drag the spores around a player with a magnet towards them
+func (w *World) pullSpores(player *objects.Player) {
+	if !player.HasEffect(objects.PowerUpMagnet) {
+		return
+	}
+
+	w.objects.Spores.QueryRadius(player.X, player.Y, player.Radius+magnetRange, func(sporeId uint64, spore *objects.Spore) {
+		distance := math.Hypot(player.X-spore.X, player.Y-spore.Y)
+		if distance == 0 {
+			return
+		}
+
+		spore.VelocityX = (player.X - spore.X) / distance * magnetPullSpeed
+		spore.VelocityY = (player.Y - spore.Y) / distance * magnetPullSpeed
+		w.movingSpores[sporeId] = spore
+	})
+}
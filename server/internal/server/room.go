@@ -0,0 +1,385 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultRoomCapacity = 20
+
+	// How long an empty room is kept around before it is torn down, so that a
+	// player respawning or switching rooms doesn't cause it to flap
+	emptyRoomGracePeriod = 30 * time.Second
+
+	// Private rooms a single client can have open at once, so nobody can spin up worlds without end
+	maxPrivateRoomsPerOwner = 1
+)
+
+// Settings every room is created with
+type RoomConfig struct {
+	TickRate int
+	Capacity int
+	GameMode string
+
+	// 0 means the game never ends on a timer
+	RoundDuration time.Duration
+
+	// The world size is ignored when there's a map, it brings its own
+	Map         *objects.Map
+	WorldWidth  int
+	WorldHeight int
+
+	// How sluggishly players turn, 0 means they change direction instantly
+	Inertia time.Duration
+
+	// Fraction of their mass big players lose every second, 0 turns decay off
+	MassDecayRate float64
+	MaxRadius     float64
+
+	SporeWeights SporeWeights
+}
+
+var DefaultRoomConfig = RoomConfig{
+	TickRate: DefaultTickRate,
+	Capacity: DefaultRoomCapacity,
+	GameMode: DefaultGameMode,
+
+	WorldWidth:  DefaultWorldSize,
+	WorldHeight: DefaultWorldSize,
+
+	Inertia: DefaultInertia,
+
+	MassDecayRate: DefaultMassDecayRate,
+	MaxRadius:     DefaultMaxRadius,
+
+	SporeWeights: DefaultSporeWeights,
+}
+
+var (
+	ErrRoomFull     = errors.New("room is full")
+	ErrRoomClosed   = errors.New("room is closed")
+	ErrRoomLocked   = errors.New("room is locked")
+	ErrBanned       = errors.New("you were kicked from this room")
+	ErrNotOwner     = errors.New("only the room owner can do that")
+	ErrNotInRoom    = errors.New("player is not in this room")
+	ErrTooManyRooms = errors.New("you already have a private room open")
+)
+
+// A Room is an independent match with its own world, players and spores
+type Room struct {
+	Id       uint64
+	Name     string
+	Capacity int
+
+	// Private rooms are hidden from listings and matchmaking, they can only be joined with the invite code
+	Private    bool
+	InviteCode string
+	OwnerId    uint64
+
+	hub    *Hub
+	world  *World
+	logger *log.Logger
+
+	members map[uint64]struct{}
+	closed  bool
+	mux     sync.Mutex
+
+	// Spectators watch the game without taking up a player slot, eliminated players watching keep the one they had
+	spectators map[uint64]struct{}
+
+	// The database ID of everyone in the room that is logged in, keyed by client ID
+	accounts map[uint64]int64
+
+	// While locked only players that were already admitted can (re)join
+	locked   bool
+	admitted map[uint64]struct{}
+
+	// Keyed by database ID, so reconnecting doesn't get around a kick
+	banned map[int64]struct{}
+}
+
+func newRoom(hub *Hub, config RoomConfig) *Room {
+	mode, err := NewGameMode(config.GameMode)
+	if err != nil {
+		log.Printf("Error creating room game mode, using %s: %v", DefaultGameMode, err)
+		mode, _ = NewGameMode(DefaultGameMode)
+	}
+
+	return &Room{
+		Capacity:   config.Capacity,
+		hub:        hub,
+		world:      NewWorld(hub, config, mode),
+		members:    make(map[uint64]struct{}),
+		spectators: make(map[uint64]struct{}),
+		accounts:   make(map[uint64]int64),
+		admitted:   make(map[uint64]struct{}),
+		banned:     make(map[int64]struct{}),
+	}
+}
+
+func (r *Room) SharedGameObjects() *SharedGameObjects {
+	return r.world.objects
+}
+
+func (r *Room) Mode() GameMode {
+	return r.world.mode
+}
+
+func (r *Room) Bounds() objects.Bounds {
+	return r.world.bounds
+}
+
+func (r *Room) Map() *objects.Map {
+	return r.world.gameMap
+}
+
+// Add the player to the room's world, as long as there is space left
+func (r *Room) Join(playerId uint64, player *objects.Player) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.closed {
+		return ErrRoomClosed
+	}
+
+	if _, isBanned := r.banned[player.DbId]; isBanned {
+		return ErrBanned
+	}
+
+	if _, wasAdmitted := r.admitted[playerId]; r.locked && !wasAdmitted {
+		return ErrRoomLocked
+	}
+
+	if _, isMember := r.members[playerId]; !isMember && len(r.members) >= r.Capacity {
+		return ErrRoomFull
+	}
+
+	// Eliminated players watch from the slot they kept until they get back in
+	if _, isSpectator := r.spectators[playerId]; isSpectator {
+		delete(r.spectators, playerId)
+		r.world.Leave(playerId)
+	}
+
+	r.members[playerId] = struct{}{}
+	r.accounts[playerId] = player.DbId
+	r.admitted[playerId] = struct{}{}
+	r.world.Join(playerId, player)
+	return nil
+}
+
+// Watch the game without playing, spectators don't count towards the capacity. The database ID is 0 if they aren't logged in.
+func (r *Room) Spectate(clientId uint64, dbId int64) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.closed {
+		return ErrRoomClosed
+	}
+
+	if _, isBanned := r.banned[dbId]; isBanned && dbId != 0 {
+		return ErrBanned
+	}
+
+	r.spectators[clientId] = struct{}{}
+	if dbId != 0 {
+		r.accounts[clientId] = dbId
+	}
+	r.world.Spectate(clientId)
+	return nil
+}
+
+// Remove the player or spectator from the room
+func (r *Room) Leave(playerId uint64) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	_, isMember := r.members[playerId]
+	_, isSpectator := r.spectators[playerId]
+	if !isMember && !isSpectator {
+		return
+	}
+
+	delete(r.members, playerId)
+	delete(r.spectators, playerId)
+	delete(r.accounts, playerId)
+	r.world.Leave(playerId)
+
+	if len(r.members) == 0 && len(r.spectators) == 0 {
+		r.scheduleTeardown()
+	}
+}
+
+func (r *Room) scheduleTeardown() {
+	r.logger.Println("Room is empty, scheduling teardown")
+	time.AfterFunc(emptyRoomGracePeriod, func() {
+		r.hub.destroyRoomIfEmpty(r.Id)
+	})
+}
+
+// Remove a player from the room for good, only the owner can do this
+func (r *Room) Kick(requesterId, playerId uint64) error {
+	r.mux.Lock()
+	if requesterId != r.OwnerId {
+		r.mux.Unlock()
+		return ErrNotOwner
+	}
+	_, isMember := r.members[playerId]
+	_, isSpectator := r.spectators[playerId]
+	if (!isMember && !isSpectator) || playerId == requesterId {
+		r.mux.Unlock()
+		return ErrNotInRoom
+	}
+	// Spectators that aren't logged in have no account to ban, they're only removed
+	if dbId := r.accounts[playerId]; dbId != 0 {
+		r.banned[dbId] = struct{}{}
+	}
+	r.mux.Unlock()
+
+	r.logger.Printf("Owner kicked player %d", playerId)
+	r.evict(playerId, "you were kicked from the room")
+	return nil
+}
+
+// Stop or resume letting new players in, only the owner can do this
+func (r *Room) SetLocked(requesterId uint64, locked bool) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if requesterId != r.OwnerId {
+		return ErrNotOwner
+	}
+
+	r.locked = locked
+	r.logger.Printf("Owner set locked to %t", locked)
+	return nil
+}
+
+// Send everyone back to the lobby and tear the room down, only the owner can do this
+func (r *Room) Close(requesterId uint64) error {
+	r.mux.Lock()
+	if requesterId != r.OwnerId {
+		r.mux.Unlock()
+		return ErrNotOwner
+	}
+	memberIds := r.everyone()
+	r.mux.Unlock()
+
+	r.logger.Println("Owner closed the room")
+	for _, memberId := range memberIds {
+		r.evict(memberId, "the room was closed")
+	}
+
+	r.hub.DestroyRoom(r.Id)
+	return nil
+}
+
+// Tell the player's client state they have to leave, and make sure they're gone even if it doesn't
+func (r *Room) evict(playerId uint64, reason string) {
+	if client, exists := r.hub.Clients.Get(playerId); exists {
+		client.ProcessMessage(0, packets.NewRemovedFromRoom(reason))
+	}
+
+	r.Leave(playerId)
+}
+
+func (r *Room) QueueInput(playerId uint64, msg packets.Msg) {
+	r.world.QueueInput(playerId, msg)
+}
+
+// Pass the message to every other player and spectator in the room
+func (r *Room) Broadcast(senderId uint64, msg packets.Msg) {
+	r.mux.Lock()
+	memberIds := r.everyone()
+	r.mux.Unlock()
+
+	for _, memberId := range memberIds {
+		if memberId == senderId {
+			continue
+		}
+
+		if client, exists := r.hub.Clients.Get(memberId); exists {
+			client.ProcessMessage(senderId, msg)
+		}
+	}
+}
+
+// Whether the client is playing or spectating in the room
+func (r *Room) Has(clientId uint64) bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	_, isMember := r.members[clientId]
+	_, isSpectator := r.spectators[clientId]
+	return isMember || isSpectator
+}
+
+func (r *Room) PlayerCount() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return len(r.members)
+}
+
+// The IDs of all players and spectators, must hold mux
+func (r *Room) everyone() []uint64 {
+	ids := make([]uint64, 0, len(r.members)+len(r.spectators))
+	for memberId := range r.members {
+		ids = append(ids, memberId)
+	}
+	for spectatorId := range r.spectators {
+		// Players watching after being eliminated are members already
+		if _, isMember := r.members[spectatorId]; !isMember {
+			ids = append(ids, spectatorId)
+		}
+	}
+	return ids
+}
+
+// Whether nobody is playing or watching
+func (r *Room) IsEmpty() bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return len(r.members) == 0 && len(r.spectators) == 0
+}
+
+func (r *Room) hasSpace() bool {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	return !r.closed && len(r.members) < r.Capacity
+}
+
+// Stop the room's simulation, it can't be joined afterwards
+func (r *Room) close() {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if r.closed {
+		return
+	}
+
+	r.closed = true
+	r.world.Stop()
+}
+
+func (r *Room) start(id uint64) {
+	r.Id = id
+	if r.Name == "" {
+		r.Name = fmt.Sprintf("Room %d", id)
+	}
+	r.logger = log.New(log.Writer(), fmt.Sprintf("Room %d: ", id), log.LstdFlags)
+	r.world.logger.SetPrefix(fmt.Sprintf("Room %d [World]: ", id))
+	r.world.roomName = r.Name
+
+	r.world.seedSpores()
+	r.world.seedHazards()
+	r.world.seedPowerUps()
+	go r.world.Run()
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"cmp"
+	"math"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"time"
+)
+
+const (
+	// How often the time left is announced, on top of every second of the final countdown
+	roundAnnounceInterval = 30
+	roundFinalCountdown   = 10
+)
+
+type roundStanding struct {
+	playerId uint64
+	player   *objects.Player
+	score    int64
+}
+
+// Start the clock on a new round, it only runs out if rounds are enabled
+func (w *World) startRound() {
+	w.roundStartedAt = time.Now()
+	if w.roundDuration <= 0 {
+		return
+	}
+
+	w.roundLeft = w.roundDuration
+	w.announcedSecondsLeft = w.roundSecondsLeft()
+	w.logger.Printf("Round started, %d seconds on the clock", w.announcedSecondsLeft)
+	w.broadcastToPlayers(packets.NewRoundCountdown(uint32(w.announcedSecondsLeft)))
+}
+
+func (w *World) tickRound(interval time.Duration) {
+	if w.roundDuration <= 0 {
+		return
+	}
+
+	w.roundLeft -= interval
+	if w.roundLeft <= 0 {
+		w.endRound()
+		return
+	}
+
+	secondsLeft := w.roundSecondsLeft()
+	if secondsLeft == w.announcedSecondsLeft {
+		return
+	}
+
+	w.announcedSecondsLeft = secondsLeft
+	if secondsLeft <= roundFinalCountdown || secondsLeft%roundAnnounceInterval == 0 {
+		w.broadcastToPlayers(packets.NewRoundCountdown(uint32(secondsLeft)))
+	}
+}
+
+func (w *World) roundSecondsLeft() int {
+	return int(math.Ceil(w.roundLeft.Seconds()))
+}
+
+// Let a player who joined mid-round know how long is left
+func (w *World) sendRoundCountdown(playerId uint64) {
+	if w.roundDuration <= 0 {
+		return
+	}
+
+	w.sendTo(playerId, 0, packets.NewRoundCountdown(uint32(w.roundSecondsLeft())))
+}
+
+// Announce and record the final standings, then reset the world for the next round
+func (w *World) endRound() {
+	standings := w.mode.Standings(w)
+
+	results := make([]*packets.RoundResultMessage, 0, len(standings))
+	for i, standing := range standings {
+		w.syncPlayerBestScore(standing.player)
+		results = append(results, &packets.RoundResultMessage{
+			PlayerId: standing.playerId,
+			Name:     standing.player.Name,
+			Mass:     uint64(standing.player.Mass()),
+			Rank:     uint32(i + 1),
+		})
+	}
+
+	w.logger.Printf("Round over with %d players", len(results))
+	w.broadcastRoundEnd(packets.NewRoundEnd(results))
+	if len(standings) > 0 {
+		w.recordRound(standings)
+	}
+
+	w.mode.OnRoundEnd(w)
+	w.resetWorld()
+	w.startRound()
+}
+
+// Spectators get the results through their client state, so anyone eliminated can get back in for the next round
+func (w *World) broadcastRoundEnd(msg packets.Msg) {
+	w.objects.Players.ForEach(func(playerId uint64, _ *objects.Player) {
+		w.sendTo(playerId, 0, msg)
+	})
+
+	for spectatorId := range w.spectators {
+		if client, exists := w.hub.Clients.Get(spectatorId); exists {
+			client.ProcessMessage(0, msg)
+		}
+	}
+}
+
+// Everyone still in the world, best score first
+func (w *World) roundStandings() []roundStanding {
+	standings := make([]roundStanding, 0, w.objects.Players.Len())
+	w.objects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		standings = append(standings, roundStanding{
+			playerId: playerId,
+			player:   player,
+			score:    w.mode.Score(player),
+		})
+	})
+
+	slices.SortStableFunc(standings, func(a, b roundStanding) int {
+		return cmp.Compare(b.score, a.score)
+	})
+	return standings
+}
+
+// Save the round and everyone's placement in it for the round history
+func (w *World) recordRound(standings []roundStanding) {
+	roundParams := db.CreateRoundParams{
+		RoomName:  w.roomName,
+		GameMode:  w.mode.Name(),
+		StartedAt: w.roundStartedAt.Unix(),
+		EndedAt:   time.Now().Unix(),
+	}
+
+	resultParams := make([]db.CreateRoundResultParams, 0, len(standings))
+	for i, standing := range standings {
+		if standing.player.DbId == 0 {
+			continue
+		}
+
+		resultParams = append(resultParams, db.CreateRoundResultParams{
+			PlayerID:  standing.player.DbId,
+			Mass:      int64(standing.player.Mass()),
+			Placement: int64(i + 1),
+		})
+	}
+
+	w.writeToDb(func() {
+		tx, err := w.hub.dbPool.BeginTx(w.dbTx.Ctx, nil)
+		if err != nil {
+			w.logger.Printf("Error starting round results transaction: %v", err)
+			return
+		}
+		defer tx.Rollback()
+
+		queries := w.dbTx.Queries.WithTx(tx)
+		round, err := queries.CreateRound(w.dbTx.Ctx, roundParams)
+		if err != nil {
+			w.logger.Printf("Error saving round: %v", err)
+			return
+		}
+
+		for _, params := range resultParams {
+			params.RoundID = round.ID
+			if err := queries.CreateRoundResult(w.dbTx.Ctx, params); err != nil {
+				w.logger.Printf("Error saving round result: %v", err)
+				return
+			}
+		}
+
+		if err := tx.Commit(); err != nil {
+			w.logger.Printf("Error committing round results: %v", err)
+		}
+	})
+}
+
+// Clear out the spores, hazards and power-ups and start everyone over from scratch
+func (w *World) resetWorld() {
+	w.forgetObjects()
+	w.objects.Spores.ForEach(func(sporeId uint64, _ *objects.Spore) {
+		w.objects.Spores.Remove(sporeId)
+	})
+	w.objects.Hazards.ForEach(func(hazardId uint64, _ *objects.Hazard) {
+		w.objects.Hazards.Remove(hazardId)
+	})
+	w.objects.PowerUps.ForEach(func(powerUpId uint64, _ *objects.PowerUp) {
+		w.objects.PowerUps.Remove(powerUpId)
+	})
+	clear(w.movingSpores)
+	w.seedSpores()
+	w.seedHazards()
+	w.seedPowerUps()
+	w.sinceReplenish = 0
+
+	w.objects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		w.removeCells(player)
+		player.Effects = nil
+		w.mode.OnPlayerLeave(w, playerId)
+		w.mode.OnPlayerJoin(w, playerId, player)
+		player.X, player.Y = w.mode.SpawnPosition(w, player)
+		protectPlayer(player)
+		resetSessionStats(player)
+		w.objects.Players.Reindex(playerId)
+	})
+}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"time"
+)
+
+// Start the player's stats over for a new life
+func resetSessionStats(player *objects.Player) {
+	player.SpawnedAt = time.Now()
+	player.PeakMass = player.Mass()
+	player.Kills = 0
+	player.SporesEaten = 0
+	player.KillerId = 0
+	player.KillerName = ""
+}
+
+func trackPeakMass(player *objects.Player) {
+	player.PeakMass = max(player.PeakMass, player.Mass())
+}
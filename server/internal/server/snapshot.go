@@ -0,0 +1,76 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// How many unacknowledged snapshots we keep around as potential baselines. Once
+// the client's last acknowledgement falls out of this window we assume it lost
+// track and send a keyframe.
+const maxSnapshotHistory = 32
+
+// The players a client was sent in each snapshot, keyed by sequence number
+type snapshotHistory struct {
+	sequence uint64
+	acked    uint64
+	sent     map[uint64]map[uint64]objects.Player
+}
+
+func newSnapshotHistory() *snapshotHistory {
+	return &snapshotHistory{
+		sent: make(map[uint64]map[uint64]objects.Player),
+	}
+}
+
+// Build the next snapshot of the visible players as a delta against the last acknowledged one
+func (h *snapshotHistory) next(visible map[uint64]objects.Player, cells []*packets.CellMessage) packets.Msg {
+	h.sequence++
+	delete(h.sent, h.sequence-maxSnapshotHistory)
+
+	baselineSequence := h.acked
+	baseline, exists := h.sent[baselineSequence]
+	if !exists {
+		// Joined recently or lost too many snapshots, start over with a keyframe
+		baselineSequence = 0
+		baseline = nil
+	}
+
+	deltas := make([]*packets.PlayerDeltaMessage, 0, len(visible))
+	for playerId, player := range visible {
+		var basePlayer *objects.Player
+		if base, known := baseline[playerId]; known {
+			basePlayer = &base
+		}
+
+		if delta := packets.NewPlayerDelta(playerId, &player, basePlayer); delta != nil {
+			deltas = append(deltas, delta)
+		}
+	}
+
+	removed := make([]uint64, 0)
+	for playerId := range baseline {
+		if _, stillVisible := visible[playerId]; !stillVisible {
+			removed = append(removed, playerId)
+		}
+	}
+
+	h.sent[h.sequence] = visible
+	return packets.NewSnapshot(h.sequence, baselineSequence, deltas, removed, cells)
+}
+
+func (h *snapshotHistory) acknowledge(sequence uint64) {
+	if sequence <= h.acked {
+		return
+	}
+	if _, exists := h.sent[sequence]; !exists {
+		return
+	}
+
+	for oldSequence := range h.sent {
+		if oldSequence < sequence {
+			delete(h.sent, oldSequence)
+		}
+	}
+	h.acked = sequence
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+	"testing"
+)
+
+func snapshotOf(t *testing.T, msg packets.Msg) *packets.SnapshotMessage {
+	t.Helper()
+	snapshot, ok := msg.(*packets.Packet_Snapshot)
+	if !ok {
+		t.Fatalf("expected a snapshot, got %T", msg)
+	}
+	return snapshot.Snapshot
+}
+
+func TestSnapshotHistoryBaselines(t *testing.T) {
+	visible := map[uint64]objects.Player{1: {Name: "a", X: 10}}
+
+	tests := []struct {
+		name string
+		// How many snapshots are sent before the one that's checked, and the
+		// acknowledgements the client sends after each of those, by how many were sent
+		sent         int
+		acks         map[int][]uint64
+		wantBaseline uint64
+	}{
+		{name: "first snapshot is a keyframe", sent: 0, wantBaseline: 0},
+		{name: "nothing acknowledged", sent: 3, wantBaseline: 0},
+		{name: "latest acknowledgement", sent: 3, acks: map[int][]uint64{3: {1, 3}}, wantBaseline: 3},
+		{name: "older acknowledgement is ignored", sent: 3, acks: map[int][]uint64{3: {3, 2}}, wantBaseline: 3},
+		{name: "unknown sequence is ignored", sent: 3, acks: map[int][]uint64{3: {2, 7}}, wantBaseline: 2},
+		{name: "acknowledged too long ago", sent: maxSnapshotHistory, acks: map[int][]uint64{1: {1}}, wantBaseline: 0},
+		{name: "acknowledged just in time", sent: maxSnapshotHistory - 1, acks: map[int][]uint64{1: {1}}, wantBaseline: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := newSnapshotHistory()
+			for sent := 1; sent <= tt.sent; sent++ {
+				history.next(visible, nil)
+				for _, sequence := range tt.acks[sent] {
+					history.acknowledge(sequence)
+				}
+			}
+
+			snapshot := snapshotOf(t, history.next(visible, nil))
+			if snapshot.Sequence != uint64(tt.sent+1) {
+				t.Errorf("sequence = %d, want %d", snapshot.Sequence, tt.sent+1)
+			}
+			if snapshot.Baseline != tt.wantBaseline {
+				t.Errorf("baseline = %d, want %d", snapshot.Baseline, tt.wantBaseline)
+			}
+		})
+	}
+}
+
+func TestSnapshotHistoryDeltas(t *testing.T) {
+	baseline := map[uint64]objects.Player{
+		1: {Name: "a", X: 10, Y: 10, Radius: 20},
+		2: {Name: "b", X: 50, Y: 50, Radius: 30},
+	}
+
+	tests := []struct {
+		name        string
+		visible     map[uint64]objects.Player
+		wantPlayers []uint64
+		wantRemoved []uint64
+	}{
+		{
+			name:        "nothing changed",
+			visible:     baseline,
+			wantPlayers: []uint64{},
+			wantRemoved: []uint64{},
+		},
+		{
+			name: "one moved",
+			visible: map[uint64]objects.Player{
+				1: {Name: "a", X: 15, Y: 10, Radius: 20},
+				2: baseline[2],
+			},
+			wantPlayers: []uint64{1},
+			wantRemoved: []uint64{},
+		},
+		{
+			name: "one left view",
+			visible: map[uint64]objects.Player{
+				1: baseline[1],
+			},
+			wantPlayers: []uint64{},
+			wantRemoved: []uint64{2},
+		},
+		{
+			name: "one came into view",
+			visible: map[uint64]objects.Player{
+				1: baseline[1],
+				2: baseline[2],
+				3: {Name: "c"},
+			},
+			wantPlayers: []uint64{3},
+			wantRemoved: []uint64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			history := newSnapshotHistory()
+			history.next(baseline, nil)
+			history.acknowledge(1)
+
+			snapshot := snapshotOf(t, history.next(tt.visible, nil))
+			players := make([]uint64, 0, len(snapshot.Players))
+			for _, delta := range snapshot.Players {
+				players = append(players, delta.Id)
+			}
+			slices.Sort(players)
+			removed := slices.Sorted(slices.Values(snapshot.RemovedPlayerIds))
+
+			if !slices.Equal(players, tt.wantPlayers) {
+				t.Errorf("players = %v, want %v", players, tt.wantPlayers)
+			}
+			if !slices.Equal(removed, tt.wantRemoved) {
+				t.Errorf("removed = %v, want %v", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestPlayerDeltaOnlyHasChangedFields(t *testing.T) {
+	base := objects.Player{Name: "a", X: 10, Y: 10, Radius: 20, Color: 3}
+
+	tests := []struct {
+		name   string
+		player objects.Player
+		check  func(*packets.PlayerDeltaMessage) bool
+	}{
+		{
+			name:   "moved",
+			player: objects.Player{Name: "a", X: 12, Y: 10, Radius: 20, Color: 3},
+			check: func(d *packets.PlayerDeltaMessage) bool {
+				return d.X != nil && *d.X == 12 && d.Y == nil && d.Name == nil && d.Radius == nil
+			},
+		},
+		{
+			name:   "grew",
+			player: objects.Player{Name: "a", X: 10, Y: 10, Radius: 25, Color: 3},
+			check: func(d *packets.PlayerDeltaMessage) bool {
+				return d.Radius != nil && *d.Radius == 25 && d.X == nil && d.Color == nil
+			},
+		},
+		{
+			name:   "gained spawn protection",
+			player: objects.Player{Name: "a", X: 10, Y: 10, Radius: 20, Color: 3, SpawnProtected: true},
+			check: func(d *packets.PlayerDeltaMessage) bool {
+				return d.SpawnProtected != nil && *d.SpawnProtected && d.X == nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := packets.NewPlayerDelta(1, &tt.player, &base)
+			if delta == nil || !tt.check(delta) {
+				t.Errorf("unexpected delta %v", delta)
+			}
+		})
+	}
+
+	if delta := packets.NewPlayerDelta(1, &base, &base); delta != nil {
+		t.Errorf("expected no delta for an unchanged player, got %v", delta)
+	}
+}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"time"
+)
+
+// How long a freshly spawned player can't be eaten
+const spawnProtectionDuration = 3 * time.Second
+
+func protectPlayer(player *objects.Player) {
+	player.SpawnProtected = true
+	player.SpawnProtectedUntil = time.Now().Add(spawnProtectionDuration)
+}
+
+func expireSpawnProtection(player *objects.Player) {
+	if player.SpawnProtected && time.Now().After(player.SpawnProtectedUntil) {
+		player.SpawnProtected = false
+	}
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"cmp"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"slices"
+)
+
+// Who a client watching the game without a player of their own is looking at
+type spectator struct {
+	// 0 follows whoever is in the lead
+	followId uint64
+
+	// The player they were last told they're watching, so they're only told again when it changes
+	shownId uint64
+}
+
+// The player the spectator is following, or the leader if they aren't following anyone still in the world.
+// If nobody is playing they look at the middle of the map.
+func (w *World) spectatorFocus(s *spectator) (uint64, *objects.Player) {
+	if s.followId != 0 {
+		if player, exists := w.objects.Players.Get(s.followId); exists {
+			return s.followId, player
+		}
+		s.followId = 0
+	}
+
+	var leaderId uint64
+	var leader *objects.Player
+	w.objects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if leader == nil || w.mode.Score(player) > w.mode.Score(leader) {
+			leaderId, leader = playerId, player
+		}
+	})
+
+	if leader == nil {
+		return 0, &objects.Player{Radius: viewportBaseRadius}
+	}
+	return leaderId, leader
+}
+
+// Show every spectator what the player they're watching sees
+func (w *World) updateSpectators() {
+	for spectatorId, s := range w.spectators {
+		focusId, focus := w.spectatorFocus(s)
+		if focusId != s.shownId {
+			s.shownId = focusId
+			w.sendTo(spectatorId, 0, packets.NewSpectateTarget(focusId, focus.Name, s.followId == 0))
+		}
+
+		w.updateInterest(spectatorId, focus)
+	}
+}
+
+func (w *World) handleSpectatorInput(s *spectator, msg packets.Msg) {
+	switch msg := msg.(type) {
+	case *packets.Packet_FollowPlayerRequest:
+		s.followId = msg.FollowPlayerRequest.PlayerId
+	case *packets.Packet_CycleSpectateTargetRequest:
+		s.followId = w.nextSpectateTarget(s.shownId, msg.CycleSpectateTargetRequest.Previous)
+	}
+}
+
+// The player after (or before) the given one in ID order, wrapping around at the ends
+func (w *World) nextSpectateTarget(currentId uint64, previous bool) uint64 {
+	playerIds := make([]uint64, 0, w.objects.Players.Len())
+	w.objects.Players.ForEach(func(playerId uint64, _ *objects.Player) {
+		playerIds = append(playerIds, playerId)
+	})
+
+	if len(playerIds) == 0 {
+		return 0
+	}
+
+	slices.SortFunc(playerIds, cmp.Compare[uint64])
+	i, found := slices.BinarySearch(playerIds, currentId)
+	if previous {
+		i = (i - 1 + len(playerIds)) % len(playerIds)
+	} else if found {
+		i = (i + 1) % len(playerIds)
+	} else {
+		// Not watching anyone in particular, the search landed right after where they'd be
+		i = i % len(playerIds)
+	}
+
+	return playerIds[i]
+}
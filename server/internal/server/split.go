@@ -0,0 +1,183 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+	"time"
+)
+
+const (
+	// Including the main body
+	maxPlayerCells = 16
+
+	// Bodies smaller than this are too small to split
+	minSplitRadius = 35.0
+
+	splitLaunchSpeed = 700.0
+
+	// Fraction of the launch speed a cell still has after a second
+	splitFriction = 0.05
+
+	// How long a cell stays split off before it heads back to merge into the main body
+	mergeDelay = 10 * time.Second
+
+	// Cells heading back need to be faster than the main body to ever catch up with it
+	mergeSpeedFactor = 1.5
+)
+
+// Everything the player eats with, the main body first
+func bodies(player *objects.Player) []objects.Body {
+	all := make([]objects.Body, 0, len(player.Cells)+1)
+	all = append(all, player)
+	for _, cell := range player.Cells {
+		all = append(all, cell)
+	}
+	return all
+}
+
+// Split every body that's big enough in two, launching the new halves in the direction the player is facing
+func (w *World) splitPlayer(playerId uint64, player *objects.Player) {
+	for _, parent := range bodies(player) {
+		if len(player.Cells)+1 >= maxPlayerCells {
+			break
+		}
+
+		if parent.Size() < minSplitRadius {
+			continue
+		}
+
+		halfMass := objects.RadToMass(parent.Size()) / 2
+		parent.Grow(-halfMass)
+		w.launchCell(playerId, player, parent, halfMass, player.Direction)
+	}
+
+	w.reindexPlayer(playerId, player)
+}
+
+// Fire a new cell with the given mass out of the edge of the parent body
+func (w *World) launchCell(playerId uint64, player *objects.Player, parent objects.Body, mass, direction float64) {
+	if player.Cells == nil {
+		player.Cells = make(map[uint64]*objects.Cell)
+	}
+
+	dirX, dirY := math.Cos(direction), math.Sin(direction)
+	x, y := parent.Position()
+	cell := &objects.Cell{
+		OwnerId:   playerId,
+		Owner:     player,
+		Radius:    objects.MassToRad(mass),
+		VelocityX: splitLaunchSpeed * dirX,
+		VelocityY: splitLaunchSpeed * dirY,
+		MergeAt:   time.Now().Add(mergeDelay),
+	}
+	cell.X, cell.Y = w.gameMap.Place(x+dirX*parent.Size(), y+dirY*parent.Size(), cell.Radius)
+	player.Cells[w.objects.Cells.Add(cell)] = cell
+}
+
+// Cells steer like their owner while they coast out their launch, and head back to the main body once they can merge
+func (w *World) moveCells(player *objects.Player, delta float64) {
+	friction := math.Pow(splitFriction, delta)
+	for _, cell := range player.Cells {
+		direction, speed := steer(player, cell.X, cell.Y, cell.Radius)
+		if time.Now().After(cell.MergeAt) {
+			direction = math.Atan2(player.Y-cell.Y, player.X-cell.X)
+			speed *= mergeSpeedFactor
+		}
+
+		x := cell.X + (speed*math.Cos(direction)+cell.VelocityX)*delta
+		y := cell.Y + (speed*math.Sin(direction)+cell.VelocityY)*delta
+		cell.X, cell.Y = w.gameMap.Place(x, y, cell.Radius)
+
+		cell.VelocityX *= friction
+		cell.VelocityY *= friction
+	}
+}
+
+// Fold every cell that's ready to merge and has reached the main body back into it
+func (w *World) mergeCells(player *objects.Player) {
+	for cellId, cell := range player.Cells {
+		if time.Now().Before(cell.MergeAt) {
+			continue
+		}
+
+		if math.Hypot(player.X-cell.X, player.Y-cell.Y) >= player.Radius {
+			continue
+		}
+
+		player.Grow(objects.RadToMass(cell.Radius))
+		w.removeCell(cellId, cell)
+	}
+}
+
+// Other players' cells the body overlaps, own cells merge instead of getting eaten
+func (w *World) consumeCells(playerId uint64, player *objects.Player, eater objects.Body) {
+	x, y := eater.Position()
+	w.objects.Cells.QueryRadius(x, y, eater.Size(), func(cellId uint64, cell *objects.Cell) {
+		if cell.OwnerId == playerId {
+			return
+		}
+
+		// The cell might have been eaten already during this pass
+		if _, exists := w.objects.Cells.Get(cellId); !exists {
+			return
+		}
+
+		if !w.canEat(player, eater, cell.Owner, cell) {
+			return
+		}
+
+		eater.Grow(objects.RadToMass(cell.Radius))
+		w.removeCell(cellId, cell)
+		w.syncPlayerBestScore(player)
+	})
+}
+
+// Shielded and freshly spawned players can't be eaten at all, otherwise the game mode judges the bodies as if each was the whole of its player
+func (w *World) canEat(eaterOwner *objects.Player, eater objects.Body, victimOwner *objects.Player, victim objects.Body) bool {
+	if victimOwner.HasEffect(objects.PowerUpShield) || victimOwner.SpawnProtected {
+		return false
+	}
+
+	eaterPlayer, victimPlayer := *eaterOwner, *victimOwner
+	eaterPlayer.Radius, victimPlayer.Radius = eater.Size(), victim.Size()
+	return w.mode.CanConsumePlayer(&eaterPlayer, &victimPlayer)
+}
+
+// Move the main body into the player's biggest cell, so losing it isn't the end while there are cells left
+func (w *World) promoteCell(playerId uint64, player *objects.Player) bool {
+	var biggestId uint64
+	var biggest *objects.Cell
+	for cellId, cell := range player.Cells {
+		if biggest == nil || cell.Radius > biggest.Radius {
+			biggestId, biggest = cellId, cell
+		}
+	}
+
+	if biggest == nil {
+		return false
+	}
+
+	player.X, player.Y, player.Radius = biggest.X, biggest.Y, biggest.Radius
+	w.removeCell(biggestId, biggest)
+	w.objects.Players.Reindex(playerId)
+	return true
+}
+
+func (w *World) removeCell(cellId uint64, cell *objects.Cell) {
+	delete(cell.Owner.Cells, cellId)
+	w.objects.Cells.Remove(cellId)
+}
+
+func (w *World) removeCells(player *objects.Player) {
+	for cellId := range player.Cells {
+		w.objects.Cells.Remove(cellId)
+	}
+	player.Cells = nil
+}
+
+func (w *World) reindexPlayer(playerId uint64, player *objects.Player) {
+	w.objects.Players.Reindex(playerId)
+	for cellId := range player.Cells {
+		w.objects.Cells.Reindex(cellId)
+	}
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"math/rand/v2"
+	"server/internal/server/objects"
+)
+
+const (
+	goldenSporeFactor = 5.0
+	poisonSporeFactor = -3.0
+
+	// Poison can't shrink a body any further than this
+	minPoisonedRadius = 10.0
+)
+
+// How likely each kind of spore is to spawn, relative to the others
+type SporeWeights struct {
+	Common int
+	Golden int
+	Poison int
+}
+
+var DefaultSporeWeights = SporeWeights{
+	Common: 90,
+	Golden: 7,
+	Poison: 3,
+}
+
+func (sw SporeWeights) total() int {
+	return sw.Common + sw.Golden + sw.Poison
+}
+
+// Pick a random kind according to the weights
+func (sw SporeWeights) pick() objects.SporeKind {
+	roll := rand.IntN(sw.total())
+	if roll < sw.Golden {
+		return objects.SporeGolden
+	}
+	if roll < sw.Golden+sw.Poison {
+		return objects.SporePoison
+	}
+	return objects.SporeCommon
+}
+
+// The mass a body of the given radius gets from eating the spore, negative for poison
+func sporeMassGain(spore *objects.Spore, eaterRadius float64) float64 {
+	mass := objects.RadToMass(spore.Radius)
+	switch spore.Kind {
+	case objects.SporeGolden:
+		return mass * goldenSporeFactor
+	case objects.SporePoison:
+		return min(0, max(mass*poisonSporeFactor, objects.RadToMass(minPoisonedRadius)-objects.RadToMass(eaterRadius)))
+	default:
+		return mass
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"math"
+	"server/internal/server/objects"
+)
+
+const (
+	sporeSpawnInterval = 1.0
+
+	// Fraction of a region's missing spores grown back every second in an empty room,
+	// and how much faster that gets with every player in it
+	sporeRefillRate      = 0.02
+	sporeRefillPerPlayer = 0.25
+
+	// Map densities are given per square of this size
+	sporeDensityArea = 1000.0 * 1000.0
+)
+
+// A part of the world the spawner keeps stocked with a set number of spores
+type sporeRegion struct {
+	area   objects.Bounds
+	target int
+
+	// The spores spawned into the region that might still be around
+	spores map[uint64]struct{}
+}
+
+// Keeps every spore region of the world topped up to its density, faster the more players there are to eat them
+type sporeSpawner struct {
+	regions    []*sporeRegion
+	sinceSpawn float64
+}
+
+// Regions from the map, or the whole world holding MaxSpores if the map doesn't have any
+func newSporeSpawner(gameMap *objects.Map) *sporeSpawner {
+	s := &sporeSpawner{}
+	for _, region := range gameMap.SporeRegions {
+		s.regions = append(s.regions, &sporeRegion{
+			area:   region.Area,
+			target: int(math.Round(region.Density * region.Area.Area() / sporeDensityArea)),
+			spores: make(map[uint64]struct{}),
+		})
+	}
+
+	if len(s.regions) == 0 {
+		s.regions = append(s.regions, &sporeRegion{
+			area:   gameMap.Bounds(),
+			target: MaxSpores,
+			spores: make(map[uint64]struct{}),
+		})
+	}
+
+	return s
+}
+
+// Fill every region up to its target in one go, for a fresh world
+func (w *World) seedSpores() {
+	w.logger.Println("Placing spores...")
+	w.sporeSpawner.sinceSpawn = 0
+	for _, region := range w.sporeSpawner.regions {
+		clear(region.spores)
+		w.spawnSpores(region, region.target)
+	}
+}
+
+// Spawn the spores the regions are missing a bit at a time, and let the players who can see them know in one go
+func (w *World) tickSporeSpawner(delta float64) {
+	s := w.sporeSpawner
+	s.sinceSpawn += delta
+	if s.sinceSpawn < sporeSpawnInterval {
+		return
+	}
+
+	rate := sporeRefillRate * (1 + sporeRefillPerPlayer*float64(w.objects.Players.Len())) * s.sinceSpawn
+	s.sinceSpawn = 0
+
+	spawned := make(map[uint64]*objects.Spore)
+	for _, region := range s.regions {
+		for sporeId := range region.spores {
+			if _, exists := w.objects.Spores.Get(sporeId); !exists {
+				delete(region.spores, sporeId)
+			}
+		}
+
+		missing := region.target - len(region.spores)
+		if missing <= 0 {
+			continue
+		}
+
+		for sporeId, spore := range w.spawnSpores(region, min(missing, int(math.Ceil(float64(missing)*rate)))) {
+			spawned[sporeId] = spore
+		}
+	}
+
+	if len(spawned) > 0 {
+		w.notifySporesSpawned(spawned)
+	}
+}
+
+func (w *World) spawnSpores(region *sporeRegion, count int) map[uint64]*objects.Spore {
+	spawned := make(map[uint64]*objects.Spore, count)
+	for range count {
+		spore := w.newSpore(region.area)
+		sporeId := w.objects.Spores.Add(spore)
+		region.spores[sporeId] = struct{}{}
+		spawned[sporeId] = spore
+	}
+	return spawned
+}
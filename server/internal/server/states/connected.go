@@ -19,6 +19,9 @@ type Connected struct {
 	logger  *log.Logger
 	queries *db.Queries
 	dbCtx   context.Context
+
+	// The room picked by the client to play in, matchmaking decides if nil
+	room *server.Room
 }
 
 func (c *Connected) Name() string {
@@ -45,6 +48,16 @@ func (c *Connected) HandleMessage(senderId uint64, msg packets.Msg) {
 		c.handleRegisterRequest(senderId, message)
 	case *packets.Packet_HiscoreBoardRequest:
 		c.handleHiscoreBoardRequest(senderId, message)
+	case *packets.Packet_RoomListRequest:
+		c.handleRoomListRequest(senderId, message)
+	case *packets.Packet_JoinRoomRequest:
+		c.handleJoinRoomRequest(senderId, message)
+	case *packets.Packet_CreateRoomRequest:
+		c.handleCreateRoomRequest(senderId, message)
+	case *packets.Packet_JoinRoomByCodeRequest:
+		c.handleJoinRoomByCodeRequest(senderId, message)
+	case *packets.Packet_SpectateRoomRequest:
+		c.handleSpectateRoomRequest(senderId, message)
 		// case *packets.
 	}
 	// if senderId == c.client.Id() {
@@ -89,10 +102,16 @@ func (c *Connected) handleLoginRequest(senderId uint64, msg *packets.Packet_Logi
 		return
 	}
 
-	c.logger.Printf("User '%s' logged in successfully", username)
+	room := c.room
+	if room == nil {
+		room = c.client.Hub().Matchmake()
+	}
+
+	c.logger.Printf("User '%s' logged in successfully, placing them in %s", username, room.Name)
 	c.client.SocketSend(packets.NewOkResponse())
 
 	c.client.SetState(&InGame{
+		room: room,
 		player: &objects.Player{
 			Name:      	username,
 			BestScore: 	player.BestScore,
@@ -163,6 +182,113 @@ func (c *Connected) handleHiscoreBoardRequest(senderId uint64, message *packets.
 	c.client.SetState(&BrowsingHiscores{})
 }
 
+func (c *Connected) handleRoomListRequest(senderId uint64, _ *packets.Packet_RoomListRequest) {
+	roomMessages := make([]*packets.RoomMessage, 0, c.client.Hub().Rooms.Len())
+	c.client.Hub().Rooms.ForEach(func(roomId uint64, room *server.Room) {
+		if room.Private {
+			return
+		}
+
+		roomMessages = append(roomMessages, &packets.RoomMessage{
+			Id:       roomId,
+			Name:     room.Name,
+			Players:  uint32(room.PlayerCount()),
+			Capacity: uint32(room.Capacity),
+		})
+	})
+
+	c.client.SocketSend(packets.NewRoomList(roomMessages))
+}
+
+func (c *Connected) handleJoinRoomRequest(senderId uint64, message *packets.Packet_JoinRoomRequest) {
+	roomId := message.JoinRoomRequest.RoomId
+	if roomId == 0 {
+		c.room = nil
+		c.client.SocketSend(packets.NewOkResponse())
+		return
+	}
+
+	room, exists := c.client.Hub().Rooms.Get(roomId)
+	if !exists || room.Private {
+		c.client.SocketSend(packets.NewDenyResponse("Room not found"))
+		return
+	}
+
+	c.pickRoom(room)
+}
+
+func (c *Connected) handleCreateRoomRequest(senderId uint64, message *packets.Packet_CreateRoomRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Invalid sender ID: %d, expected: %d", senderId, c.client.Id())
+		return
+	}
+
+	name := strings.TrimSpace(message.CreateRoomRequest.Name)
+	if len(name) > 30 {
+		c.client.SocketSend(packets.NewDenyResponse("Room name must be at most 30 characters"))
+		return
+	}
+
+	room, err := c.client.Hub().CreatePrivateRoom(c.client.Id(), name, int(message.CreateRoomRequest.Capacity))
+	if err != nil {
+		c.logger.Printf("Failed to create private room: %v", err)
+		c.client.SocketSend(packets.NewDenyResponse(err.Error()))
+		return
+	}
+
+	c.room = room
+	c.client.SocketSend(packets.NewRoomCreated(room.Id, room.InviteCode))
+}
+
+func (c *Connected) handleJoinRoomByCodeRequest(senderId uint64, message *packets.Packet_JoinRoomByCodeRequest) {
+	room, exists := c.client.Hub().FindRoomByCode(strings.TrimSpace(message.JoinRoomByCodeRequest.InviteCode))
+	if !exists {
+		c.client.SocketSend(packets.NewDenyResponse("No room found with that invite code"))
+		return
+	}
+
+	c.pickRoom(room)
+}
+
+// Watch a room without logging in or taking up a spot in it, the picked or matchmade room if the ID is 0
+func (c *Connected) handleSpectateRoomRequest(senderId uint64, message *packets.Packet_SpectateRoomRequest) {
+	if senderId != c.client.Id() {
+		c.logger.Printf("Invalid sender ID: %d, expected: %d", senderId, c.client.Id())
+		return
+	}
+
+	room := c.room
+	if roomId := message.SpectateRoomRequest.RoomId; roomId != 0 {
+		var exists bool
+		room, exists = c.client.Hub().Rooms.Get(roomId)
+		if !exists || room.Private {
+			c.client.SocketSend(packets.NewDenyResponse("Room not found"))
+			return
+		}
+	} else if room == nil {
+		var exists bool
+		room, exists = c.client.Hub().BusiestRoom()
+		if !exists {
+			c.client.SocketSend(packets.NewDenyResponse("No rooms to spectate"))
+			return
+		}
+	}
+
+	c.client.SocketSend(packets.NewOkResponse())
+	c.client.SetState(&Spectating{room: room})
+}
+
+func (c *Connected) pickRoom(room *server.Room) {
+	if room.PlayerCount() >= room.Capacity {
+		c.client.SocketSend(packets.NewDenyResponse("Room is full"))
+		return
+	}
+
+	c.logger.Printf("Client picked %s", room.Name)
+	c.room = room
+	c.client.SocketSend(packets.NewOkResponse())
+}
+
 func validateUsername(username string) error {
 	if len(username) < 3 || len(username) > 20 {
 		return errors.New("username must be between 3 and 20 characters")
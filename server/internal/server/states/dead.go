@@ -0,0 +1,111 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+// The player was eaten and is looking at their death summary, they get back in when they ask to respawn
+type Dead struct {
+	client server.ClientInterfacer
+	room   *server.Room
+	logger *log.Logger
+
+	// The player that was eaten, they respawn as the same account
+	player *objects.Player
+
+	// Respawning or watching the room keeps the slot the player has in it, going anywhere else gives it up
+	keepSlot bool
+}
+
+func (d *Dead) Name() string {
+	return "Dead"
+}
+
+func (d *Dead) SetClient(client server.ClientInterfacer) {
+	d.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), d.Name())
+	d.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+}
+
+func (d *Dead) OnEnter() {
+	d.logger.Printf("%s died, waiting for them to respawn", d.player.Name)
+}
+
+func (d *Dead) HandleMessage(senderId uint64, msg packets.Msg) {
+	switch msg := msg.(type) {
+	case *packets.Packet_RespawnRequest:
+		d.handleRespawnRequest(senderId, msg)
+	case *packets.Packet_SpectateRoomRequest:
+		d.handleSpectateRoomRequest(senderId, msg)
+	case *packets.Packet_RemovedFromRoom:
+		d.handleRemovedFromRoom(senderId, msg)
+	case *packets.Packet_Disconnect:
+		d.handleDisconnect(senderId, msg)
+	}
+}
+
+func (d *Dead) OnExit() {
+	if !d.keepSlot {
+		d.logger.Printf("Removing player %s from %s", d.player.Name, d.room.Name)
+		d.room.Leave(d.client.Id())
+	}
+}
+
+func (d *Dead) handleRespawnRequest(senderId uint64, _ *packets.Packet_RespawnRequest) {
+	if senderId != d.client.Id() {
+		d.logger.Printf("Received respawn request from another client (ID: %d), ignoring", senderId)
+		return
+	}
+
+	d.logger.Println("Respawning...")
+	d.keepSlot = true
+	d.client.SetState(&InGame{
+		room:   d.room,
+		player: respawnedPlayer(d.player),
+	})
+}
+
+// Watch the room the player died in, they can still respawn from there
+func (d *Dead) handleSpectateRoomRequest(senderId uint64, _ *packets.Packet_SpectateRoomRequest) {
+	if senderId != d.client.Id() {
+		d.logger.Printf("Received spectate request from another client (ID: %d), ignoring", senderId)
+		return
+	}
+
+	d.keepSlot = true
+	d.client.SetState(&Spectating{
+		room:   d.room,
+		player: d.player,
+	})
+}
+
+func (d *Dead) handleRemovedFromRoom(senderId uint64, msg *packets.Packet_RemovedFromRoom) {
+	if senderId == d.client.Id() {
+		d.logger.Println("Received removed from room message from our own client, ignoring")
+		return
+	}
+
+	d.logger.Printf("Removed from %s because %s", d.room.Name, msg.RemovedFromRoom.Reason)
+	d.client.SocketSendAs(msg, senderId)
+	d.client.SetState(&Connected{})
+}
+
+func (d *Dead) handleDisconnect(senderId uint64, _ *packets.Packet_Disconnect) {
+	if senderId == d.client.Id() {
+		d.client.SetState(&Connected{})
+	}
+}
+
+// A fresh player for the same account, everything else starts over
+func respawnedPlayer(player *objects.Player) *objects.Player {
+	return &objects.Player{
+		Name:      player.Name,
+		BestScore: player.BestScore,
+		DbId:      player.DbId,
+		Color:     player.Color,
+	}
+}
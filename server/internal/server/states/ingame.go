@@ -1,23 +1,22 @@
 package states
 
 import (
-	"context"
 	"fmt"
 	"log"
 	"math"
-	"math/rand/v2"
 	"server/internal/server"
-	"server/internal/server/db"
 	"server/internal/server/objects"
 	"server/pkg/packets"
-	"time"
 )
 
 type InGame struct {
-	client                 server.ClientInterfacer
-	player                 *objects.Player
-	logger                 *log.Logger
-	cancelPlayerUpdateLoop context.CancelFunc
+	client server.ClientInterfacer
+	room   *server.Room
+	player *objects.Player
+	logger *log.Logger
+
+	// Eaten players keep their slot in the room while they're dead or watching, so they can get back in even if it fills up
+	keepSlot bool
 }
 
 func (g *InGame) Name() string {
@@ -31,19 +30,18 @@ func (g *InGame) SetClient(client server.ClientInterfacer) {
 }
 
 func (g *InGame) OnEnter() {
-	g.logger.Printf("Adding player %s to the shared collection", g.player.Name)
-	go g.client.SharedGameObjects().Players.Add(g.player, g.client.Id())
-
-	// Set initial properties for the player
-	// g.player.X = rand.Float64() * 1000
-	// g.player.Y = rand.Float64() * 1000
-	g.player.X, g.player.Y = objects.SpawnCoords(g.player.Radius, g.client.SharedGameObjects().Players, nil)
-	g.player.Speed = 150.0
-	g.player.Radius = 20.0
-
-	g.client.SocketSend(packets.NewPlayer(g.client.Id(), g.player))
+	// The room's game mode sets up the player, the world takes ownership of it from here on
+	g.logger.Printf("Adding player %s to %s", g.player.Name, g.room.Name)
+	if err := g.room.Join(g.client.Id(), g.player); err != nil {
+		g.logger.Printf("Failed to join %s: %v", g.room.Name, err)
+		g.client.SocketSend(packets.NewDenyResponse("Could not join room: " + err.Error()))
+		g.client.SetState(&Connected{})
+		return
+	}
 
-	go g.sendInitialSpores(20, 50)
+	// So the client can draw the walls and obstacles
+	g.client.SocketSend(packets.NewWorldBounds(g.room.Bounds()))
+	g.client.SocketSend(packets.NewGameMap(g.room.Map()))
 }
 
 func (g *InGame) HandleMessage(senderId uint64, msg packets.Msg) {
@@ -52,6 +50,12 @@ func (g *InGame) HandleMessage(senderId uint64, msg packets.Msg) {
 		g.handlePlayer(senderId, msg)
 	case *packets.Packet_PlayerDirection:
 		g.handlePlayerDirection(senderId, msg)
+	case *packets.Packet_PlayerTarget:
+		g.handlePlayerTarget(senderId, msg)
+	case *packets.Packet_PlayerSplit:
+		g.handlePlayerSplit(senderId, msg)
+	case *packets.Packet_PlayerEject:
+		g.handlePlayerEject(senderId, msg)
 	case *packets.Packet_Chat:
 		g.handleChat(senderId, msg)
 	case *packets.Packet_SporeConsumed:
@@ -62,16 +66,26 @@ func (g *InGame) HandleMessage(senderId uint64, msg packets.Msg) {
 		g.handleSpore(senderId, msg)
 	case *packets.Packet_Disconnect:
 		g.handleDisconnect(senderId, msg)
+	case *packets.Packet_SnapshotAck:
+		g.handleSnapshotAck(senderId, msg)
+	case *packets.Packet_KickPlayerRequest:
+		g.handleKickPlayerRequest(senderId, msg)
+	case *packets.Packet_LockRoomRequest:
+		g.handleLockRoomRequest(senderId, msg)
+	case *packets.Packet_CloseRoomRequest:
+		g.handleCloseRoomRequest(senderId, msg)
+	case *packets.Packet_RemovedFromRoom:
+		g.handleRemovedFromRoom(senderId, msg)
 	}
 }
 
 func (g *InGame) OnExit() {
-	g.logger.Printf("Removing player %s from the shared collection", g.player.Name)
-	if g.cancelPlayerUpdateLoop != nil {
-		g.cancelPlayerUpdateLoop()
+	if g.keepSlot {
+		return
 	}
-	g.syncPlayerBestScore()
-	go g.client.SharedGameObjects().Players.Remove(g.client.Id())
+
+	g.logger.Printf("Removing player %s from %s", g.player.Name, g.room.Name)
+	g.room.Leave(g.client.Id())
 }
 
 func (g *InGame) handlePlayer(senderId uint64, message *packets.Packet_Player) {
@@ -85,7 +99,7 @@ func (g *InGame) handlePlayer(senderId uint64, message *packets.Packet_Player) {
 
 func (g *InGame) handleChat(senderId uint64, msg *packets.Packet_Chat) {
 	if senderId == g.client.Id() {
-		g.client.Broadcast(msg)
+		g.room.Broadcast(senderId, msg)
 	} else {
 		g.client.SocketSendAs(msg, senderId)
 	}
@@ -97,240 +111,161 @@ func (g *InGame) handlePlayerDirection(senderId uint64, msg *packets.Packet_Play
 		return
 	}
 
-	g.player.Direction = msg.PlayerDirection.Direction
-
-	if g.cancelPlayerUpdateLoop == nil {
-		ctx, cancel := context.WithCancel(context.Background())
-		g.cancelPlayerUpdateLoop = cancel
-		go g.playerUpdateLoop(ctx)
+	if !isFinite(msg.PlayerDirection.Direction) {
+		g.logger.Println("Received a direction that isn't a number, ignoring")
+		return
 	}
 
+	g.room.QueueInput(senderId, msg)
 }
 
-func (g *InGame) handleSporeConsumed(senderId uint64, msg *packets.Packet_SporeConsumed) {
+func (g *InGame) handlePlayerTarget(senderId uint64, msg *packets.Packet_PlayerTarget) {
 	if senderId != g.client.Id() {
-		g.client.SocketSendAs(msg, senderId)
+		g.logger.Printf("Received player target message from another client (ID: %d), ignoring", senderId)
 		return
 	}
 
-	errMsg := "Could not verify spore consumption: "
-
-	sporeId := msg.SporeConsumed.SporeId
-	spore, err := g.getSpore(sporeId)
-	if err != nil {
-		g.logger.Printf("%s%s", errMsg, err.Error())
+	if !isFinite(msg.PlayerTarget.X, msg.PlayerTarget.Y) {
+		g.logger.Println("Received a target that isn't a point, ignoring")
 		return
 	}
 
-	err = g.validatePlayerCloseToObject(spore.X, spore.Y, spore.Radius, 10.0)
-	if err != nil {
-		g.logger.Printf("%s%s", errMsg, err.Error())
-		return
-	}
+	g.room.QueueInput(senderId, msg)
+}
 
-	err = g.validatePlayerDropCooldown(spore, 10)
-	if err != nil {
-		g.logger.Printf("%s%s", errMsg, err.Error())
+func (g *InGame) handlePlayerSplit(senderId uint64, msg *packets.Packet_PlayerSplit) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received player split message from another client (ID: %d), ignoring", senderId)
 		return
 	}
 
-	sporeMass := radToMass(spore.Radius)
-	g.player.Radius = g.nextRadius(sporeMass)
-
-	go g.client.SharedGameObjects().Spores.Remove(sporeId)
+	g.room.QueueInput(senderId, msg)
+}
 
-	g.client.Broadcast(msg)
+func (g *InGame) handlePlayerEject(senderId uint64, msg *packets.Packet_PlayerEject) {
+	if senderId != g.client.Id() {
+		g.logger.Printf("Received player eject message from another client (ID: %d), ignoring", senderId)
+		return
+	}
 
-	go g.syncPlayerBestScore()
+	g.room.QueueInput(senderId, msg)
 }
 
-func (g *InGame) handlePlayerConsumed(senderId uint64, msg *packets.Packet_PlayerConsumed) {
+func (g *InGame) handleSnapshotAck(senderId uint64, msg *packets.Packet_SnapshotAck) {
 	if senderId != g.client.Id() {
-		g.client.SocketSendAs(msg, senderId)
+		g.logger.Printf("Received snapshot ack from another client (ID: %d), ignoring", senderId)
+		return
+	}
 
-		if msg.PlayerConsumed.PlayerId == g.client.Id() {
-			g.logger.Println("Player was consumed, respawning...")
-			g.client.SetState(&InGame{
-				player: &objects.Player{
-					Name: g.player.Name,
-				},
-			})
-		}
+	g.room.QueueInput(senderId, msg)
+}
 
+func (g *InGame) handleKickPlayerRequest(senderId uint64, msg *packets.Packet_KickPlayerRequest) {
+	if senderId != g.client.Id() {
 		return
 	}
 
-	errMsg := "Could not verify player consumption: "
+	g.respondToRoomControl(g.room.Kick(senderId, msg.KickPlayerRequest.PlayerId))
+}
 
-	otherId := msg.PlayerConsumed.PlayerId
-	other, err := g.getOtherPlayer(otherId)
-	if err != nil {
-		g.logger.Printf("%s%s", errMsg, err.Error())
+func (g *InGame) handleLockRoomRequest(senderId uint64, msg *packets.Packet_LockRoomRequest) {
+	if senderId != g.client.Id() {
 		return
 	}
 
-	ourMass := radToMass(g.player.Radius)
-	otherMass := radToMass(other.Radius)
-	if ourMass <= otherMass*1.5 {
-		g.logger.Println(errMsg + "player's mass not enough")
+	g.respondToRoomControl(g.room.SetLocked(senderId, msg.LockRoomRequest.Locked))
+}
+
+func (g *InGame) handleCloseRoomRequest(senderId uint64, msg *packets.Packet_CloseRoomRequest) {
+	if senderId != g.client.Id() {
 		return
 	}
 
-	err = g.validatePlayerCloseToObject(other.X, other.Y, other.Radius, 10.0)
+	g.respondToRoomControl(g.room.Close(senderId))
+}
+
+func (g *InGame) respondToRoomControl(err error) {
 	if err != nil {
-		g.logger.Printf("%s%s", errMsg, err.Error())
+		g.logger.Printf("Room control request denied: %v", err)
+		g.client.SocketSend(packets.NewDenyResponse(err.Error()))
 		return
 	}
 
-	g.player.Radius = g.nextRadius(otherMass)
-
-	go g.client.SharedGameObjects().Players.Remove(otherId)
-	g.client.Broadcast(msg)
-
-	go g.syncPlayerBestScore()
-
-}
-
-func (g *InGame) handleSpore(senderId uint64, msg *packets.Packet_Spore) {
-	g.client.SocketSendAs(msg, senderId)
+	g.client.SocketSend(packets.NewOkResponse())
 }
 
-func (g *InGame) handleDisconnect(senderId uint64, msg *packets.Packet_Disconnect) {
+func (g *InGame) handleRemovedFromRoom(senderId uint64, msg *packets.Packet_RemovedFromRoom) {
 	if senderId == g.client.Id() {
-		g.client.Broadcast(msg)
-		g.client.SetState(&Connected{})
+		g.logger.Println("Received removed from room message from our own client, ignoring")
 		return
 	}
 
-	go g.client.SocketSendAs(msg, senderId)
-}
-
-func (g *InGame) playerUpdateLoop(ctx context.Context) {
-	const delta float64 = 0.05
-	ticker := time.NewTicker(time.Duration(delta*1000) * time.Millisecond)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			g.syncPlayer(delta)
-		case <-ctx.Done():
-			return
-		}
-	}
+	g.logger.Printf("Removed from %s because %s", g.room.Name, msg.RemovedFromRoom.Reason)
+	g.client.SocketSendAs(msg, senderId)
+	g.client.SetState(&Connected{})
 }
 
-func (g *InGame) syncPlayer(delta float64) {
-	newX := g.player.X + g.player.Speed*math.Cos(g.player.Direction)*delta
-	newY := g.player.Y + g.player.Speed*math.Sin(g.player.Direction)*delta
-
-	g.player.X = newX
-	g.player.Y = newY
-
-	probability := g.player.Radius / float64(server.MaxSpores*5)
-	if rand.Float64() < probability && g.player.Radius > 10 {
-		spore := &objects.Spore{
-			X:         g.player.X,
-			Y:         g.player.Y,
-			Radius:    min(5+g.player.Radius/50, 15),
-			DroppedBy: g.player,
-			DroppedAt: time.Now(),
-		}
-		sporeId := g.client.SharedGameObjects().Spores.Add(spore)
-		g.client.Broadcast(packets.NewSpore(sporeId, spore))
-		go g.client.SocketSend(packets.NewSpore(sporeId, spore))
-		g.player.Radius = g.nextRadius(-radToMass(spore.Radius))
+func (g *InGame) handleSporeConsumed(senderId uint64, msg *packets.Packet_SporeConsumed) {
+	if senderId == g.client.Id() {
+		g.logger.Printf("Ignoring claim to have consumed spore %d, the world decides what gets eaten", msg.SporeConsumed.SporeId)
+		return
 	}
 
-	updatePacket := packets.NewPlayer(g.client.Id(), g.player)
-	g.client.Broadcast(updatePacket)
-	go g.client.SocketSend(updatePacket)
+	g.client.SocketSendAs(msg, senderId)
 }
 
-func (g *InGame) sendInitialSpores(batchSize int, delay time.Duration) {
-	sporesBatch := make(map[uint64]*objects.Spore, batchSize)
-
-	g.client.SharedGameObjects().Spores.ForEach(func(sporeId uint64, spore *objects.Spore) {
-		sporesBatch[sporeId] = spore
-
-		if len(sporesBatch) >= batchSize {
-			g.client.SocketSend(packets.NewSporesBatch(sporesBatch))
-			sporesBatch = make(map[uint64]*objects.Spore, batchSize)
-			time.Sleep(delay * time.Millisecond)
-		}
-	})
-
-	// Send any remaining spores in the batch
-	if len(sporesBatch) > 0 {
-		g.client.SocketSend(packets.NewSporesBatch(sporesBatch))
+func (g *InGame) handlePlayerConsumed(senderId uint64, msg *packets.Packet_PlayerConsumed) {
+	if senderId == g.client.Id() {
+		g.logger.Printf("Ignoring claim to have consumed player %d, the world decides what gets eaten", msg.PlayerConsumed.PlayerId)
+		return
 	}
-}
 
-func (g *InGame) getSpore(sporeId uint64) (*objects.Spore, error) {
-	spore, exists := g.client.SharedGameObjects().Spores.Get(sporeId)
-	if !exists {
-		return nil, fmt.Errorf("spore with ID %d not found", sporeId)
-	}
-	return spore, nil
-}
+	g.client.SocketSendAs(msg, senderId)
 
-func (g *InGame) getOtherPlayer(playerId uint64) (*objects.Player, error) {
-	player, exists := g.client.SharedGameObjects().Players.Get(playerId)
-	if !exists {
-		return nil, fmt.Errorf("player with ID %d not found", playerId)
+	if msg.PlayerConsumed.PlayerId != g.client.Id() {
+		return
 	}
-	return player, nil
-}
-
-func (g *InGame) validatePlayerCloseToObject(objX, objY, objRadius, buffer float64) error {
-	realDX := g.player.X - objX
-	realDY := g.player.Y - objY
-	realDistanceSq := realDX*realDX + realDY*realDY
 
-	thresholdDistance := g.player.Radius + buffer + objRadius
-	thresholdDistanceSq := thresholdDistance * thresholdDistance
+	g.client.SocketSend(packets.NewDeathSummary(g.player))
 
-	if realDistanceSq > thresholdDistanceSq {
-		return fmt.Errorf("player is too far from the object (distance: %f, threshold: %f)", realDistanceSq, thresholdDistance)
+	if !g.room.Mode().Respawns() {
+		g.logger.Println("Player was eliminated, spectating until the round is over")
+		g.keepSlot = true
+		g.client.SetState(&Spectating{
+			room:   g.room,
+			player: g.player,
+		})
+		return
 	}
 
-	return nil
+	g.logger.Println("Player was consumed")
+	g.keepSlot = true
+	g.client.SetState(&Dead{
+		room:   g.room,
+		player: g.player,
+	})
 }
 
-func massToRad(mass float64) float64 {
-	return math.Sqrt(mass / math.Pi)
+func (g *InGame) handleSpore(senderId uint64, msg *packets.Packet_Spore) {
+	g.client.SocketSendAs(msg, senderId)
 }
 
-func radToMass(radius float64) float64 {
-	return math.Pi * radius * radius
-}
+func (g *InGame) handleDisconnect(senderId uint64, msg *packets.Packet_Disconnect) {
+	if senderId == g.client.Id() {
+		g.room.Broadcast(senderId, msg)
+		g.client.SetState(&Connected{})
+		return
+	}
 
-func (g *InGame) nextRadius(massDiff float64) float64 {
-	oldMass := radToMass(g.player.Radius)
-	newMass := oldMass + massDiff
-	return massToRad(newMass)
+	go g.client.SocketSendAs(msg, senderId)
 }
 
-func (g *InGame) syncPlayerBestScore() {
-	currentScore := int64(math.Floor(radToMass(g.player.Radius)))
-	if currentScore > g.player.BestScore {
-		g.player.BestScore = currentScore
-		err := g.client.DbTx().Queries.UpdatePlayerBestScore(g.client.DbTx().Ctx, db.UpdatePlayerBestScoreParams{
-			ID:        g.player.DbId,
-			BestScore: g.player.BestScore,
-		})
-		if err != nil {
-			g.logger.Printf("Error updating player best score: %v", err)
+// A NaN or infinite input would spread to the player's position, and nothing can collide with a player that isn't anywhere
+func isFinite(values ...float64) bool {
+	for _, value := range values {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return false
 		}
 	}
-}
-
-func (g *InGame) validatePlayerDropCooldown(spore *objects.Spore, buffer float64) error {
-	minAcceptableDistance := spore.Radius + g.player.Radius + buffer
-	minAcceptableTime := time.Duration(minAcceptableDistance/g.player.Speed*1000) * time.Millisecond
-	if spore.DroppedBy == g.player && time.Since(spore.DroppedAt) < minAcceptableTime {
-		return fmt.Errorf("player dropped the spore too recently (time %v, min acceptable time: %v)", time.Since(spore.DroppedAt), minAcceptableTime)
-	}
-
-	return nil
+	return true
 }
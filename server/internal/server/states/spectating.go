@@ -0,0 +1,169 @@
+package states
+
+import (
+	"fmt"
+	"log"
+	"server/internal/server"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+type Spectating struct {
+	client server.ClientInterfacer
+	room   *server.Room
+	logger *log.Logger
+
+	// The player that died or was eliminated, they get back in with it when they respawn or the round is over.
+	// Nil if they came to watch without playing.
+	player *objects.Player
+
+	// Players keep their slot in the room while they watch, getting back in takes it over
+	rejoining bool
+}
+
+func (s *Spectating) Name() string {
+	return "Spectating"
+}
+
+func (s *Spectating) SetClient(client server.ClientInterfacer) {
+	s.client = client
+	loggingPrefix := fmt.Sprintf("Client %d [%s]: ", client.Id(), s.Name())
+	s.logger = log.New(log.Writer(), loggingPrefix, log.LstdFlags)
+}
+
+func (s *Spectating) OnEnter() {
+	s.logger.Printf("Watching %s", s.room.Name)
+	var dbId int64
+	if s.player != nil {
+		dbId = s.player.DbId
+	}
+
+	if err := s.room.Spectate(s.client.Id(), dbId); err != nil {
+		s.logger.Printf("Failed to spectate %s: %v", s.room.Name, err)
+		s.client.SocketSend(packets.NewDenyResponse("Could not spectate room: " + err.Error()))
+		s.client.SetState(&Connected{})
+	}
+}
+
+func (s *Spectating) HandleMessage(senderId uint64, msg packets.Msg) {
+	switch msg := msg.(type) {
+	case *packets.Packet_Chat:
+		s.handleChat(senderId, msg)
+	case *packets.Packet_SnapshotAck:
+		s.handleSnapshotAck(senderId, msg)
+	case *packets.Packet_FollowPlayerRequest:
+		s.handleFollowPlayerRequest(senderId, msg)
+	case *packets.Packet_CycleSpectateTargetRequest:
+		s.handleCycleSpectateTargetRequest(senderId, msg)
+	case *packets.Packet_RespawnRequest:
+		s.handleRespawnRequest(senderId, msg)
+	case *packets.Packet_RoundEnd:
+		s.handleRoundEnd(senderId, msg)
+	case *packets.Packet_RemovedFromRoom:
+		s.handleRemovedFromRoom(senderId, msg)
+	case *packets.Packet_Disconnect:
+		s.handleDisconnect(senderId, msg)
+	}
+}
+
+func (s *Spectating) OnExit() {
+	s.logger.Printf("No longer watching %s", s.room.Name)
+	if !s.rejoining {
+		s.room.Leave(s.client.Id())
+	}
+}
+
+func (s *Spectating) handleChat(senderId uint64, msg *packets.Packet_Chat) {
+	if senderId == s.client.Id() {
+		s.room.Broadcast(senderId, msg)
+	} else {
+		s.client.SocketSendAs(msg, senderId)
+	}
+}
+
+func (s *Spectating) handleSnapshotAck(senderId uint64, msg *packets.Packet_SnapshotAck) {
+	if senderId != s.client.Id() {
+		s.logger.Printf("Received snapshot ack from another client (ID: %d), ignoring", senderId)
+		return
+	}
+
+	s.room.QueueInput(senderId, msg)
+}
+
+func (s *Spectating) handleFollowPlayerRequest(senderId uint64, msg *packets.Packet_FollowPlayerRequest) {
+	if senderId != s.client.Id() {
+		s.logger.Printf("Received follow player request from another client (ID: %d), ignoring", senderId)
+		return
+	}
+
+	s.room.QueueInput(senderId, msg)
+}
+
+func (s *Spectating) handleCycleSpectateTargetRequest(senderId uint64, msg *packets.Packet_CycleSpectateTargetRequest) {
+	if senderId != s.client.Id() {
+		s.logger.Printf("Received cycle spectate target request from another client (ID: %d), ignoring", senderId)
+		return
+	}
+
+	s.room.QueueInput(senderId, msg)
+}
+
+// Only players who died in a mode with respawns can get back in before the round is over
+func (s *Spectating) handleRespawnRequest(senderId uint64, _ *packets.Packet_RespawnRequest) {
+	if senderId != s.client.Id() {
+		s.logger.Printf("Received respawn request from another client (ID: %d), ignoring", senderId)
+		return
+	}
+
+	if s.player == nil || !s.room.Mode().Respawns() {
+		s.client.SocketSend(packets.NewDenyResponse("You can't respawn right now"))
+		return
+	}
+
+	s.logger.Println("Respawning...")
+	s.rejoining = true
+	s.client.SetState(&InGame{
+		room:   s.room,
+		player: respawnedPlayer(s.player),
+	})
+}
+
+func (s *Spectating) handleRoundEnd(senderId uint64, msg *packets.Packet_RoundEnd) {
+	if senderId == s.client.Id() {
+		s.logger.Println("Received round end message from our own client, ignoring")
+		return
+	}
+
+	s.client.SocketSendAs(msg, senderId)
+
+	if s.player == nil {
+		return
+	}
+
+	s.logger.Println("Round is over, getting back in the game")
+	s.rejoining = true
+	s.client.SetState(&InGame{
+		room:   s.room,
+		player: respawnedPlayer(s.player),
+	})
+}
+
+func (s *Spectating) handleRemovedFromRoom(senderId uint64, msg *packets.Packet_RemovedFromRoom) {
+	if senderId == s.client.Id() {
+		s.logger.Println("Received removed from room message from our own client, ignoring")
+		return
+	}
+
+	s.logger.Printf("Removed from %s because %s", s.room.Name, msg.RemovedFromRoom.Reason)
+	s.client.SocketSendAs(msg, senderId)
+	s.client.SetState(&Connected{})
+}
+
+func (s *Spectating) handleDisconnect(senderId uint64, msg *packets.Packet_Disconnect) {
+	if senderId == s.client.Id() {
+		s.client.SetState(&Connected{})
+		return
+	}
+
+	go s.client.SocketSendAs(msg, senderId)
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"server/internal/server/objects"
+	"server/pkg/packets"
+)
+
+const (
+	defaultTeamCount       = 2
+	teamScoreboardInterval = 2.0
+)
+
+type team struct {
+	name  string
+	color uint32
+}
+
+var teamPresets = []team{
+	{name: "Red", color: 0xE74C3CFF},
+	{name: "Blue", color: 0x3498DBFF},
+	{name: "Green", color: 0x2ECC71FF},
+	{name: "Yellow", color: 0xF1C40FFF},
+}
+
+// Players are split into balanced teams that can't eat each other and compete on their combined mass
+type Teams struct {
+	FreeForAll
+
+	teamCount int
+
+	// The team number of every player in the world, teams are numbered from 1
+	members         map[uint64]uint32
+	sinceScoreboard float64
+}
+
+func NewTeams(teamCount int) *Teams {
+	return &Teams{
+		teamCount: max(2, min(teamCount, len(teamPresets))),
+		members:   make(map[uint64]uint32),
+	}
+}
+
+func (m *Teams) Name() string {
+	return "teams"
+}
+
+func (m *Teams) OnPlayerJoin(w *World, playerId uint64, player *objects.Player) {
+	m.FreeForAll.OnPlayerJoin(w, playerId, player)
+
+	player.Team = m.smallestTeam()
+	player.Color = teamPresets[player.Team-1].color
+	m.members[playerId] = player.Team
+
+	assignment := packets.NewTeamAssignment(playerId, player.Team)
+	w.broadcastToPlayers(assignment)
+
+	// Players carried over into a new round are in the broadcast already
+	if _, inWorld := w.objects.Players.Get(playerId); !inWorld {
+		w.notifyJoining(playerId, assignment)
+	}
+}
+
+func (m *Teams) OnPlayerLeave(w *World, playerId uint64) {
+	delete(m.members, playerId)
+}
+
+func (m *Teams) OnPlayerConsumed(w *World, eaterId, victimId uint64) {
+	delete(m.members, victimId)
+}
+
+func (m *Teams) CanConsumePlayer(eater, victim *objects.Player) bool {
+	if eater.Team != 0 && eater.Team == victim.Team {
+		return false
+	}
+
+	return m.FreeForAll.CanConsumePlayer(eater, victim)
+}
+
+func (m *Teams) Tick(w *World, delta float64) {
+	m.sinceScoreboard += delta
+	if m.sinceScoreboard < teamScoreboardInterval {
+		return
+	}
+	m.sinceScoreboard = 0
+
+	w.broadcastToPlayers(packets.NewTeamScoreboard(m.scoreboard(w)))
+}
+
+func (m *Teams) smallestTeam() uint32 {
+	counts := make([]int, m.teamCount)
+	for _, teamNumber := range m.members {
+		counts[teamNumber-1]++
+	}
+
+	smallest := 0
+	for i, count := range counts {
+		if count < counts[smallest] {
+			smallest = i
+		}
+	}
+
+	return uint32(smallest + 1)
+}
+
+func (m *Teams) scoreboard(w *World) []*packets.TeamScoreMessage {
+	scores := make([]*packets.TeamScoreMessage, m.teamCount)
+	for i := range scores {
+		scores[i] = &packets.TeamScoreMessage{
+			Team:  uint32(i + 1),
+			Name:  teamPresets[i].name,
+			Color: teamPresets[i].color,
+		}
+	}
+
+	for playerId, teamNumber := range m.members {
+		player, exists := w.objects.Players.Get(playerId)
+		if !exists {
+			continue
+		}
+
+		scores[teamNumber-1].Mass += uint64(m.Score(player))
+		scores[teamNumber-1].Players++
+	}
+
+	return scores
+}
@@ -0,0 +1,427 @@
+package server
+
+import (
+	"log"
+	"math/rand/v2"
+	"server/internal/server/db"
+	"server/internal/server/objects"
+	"server/pkg/packets"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultTickRate = 20
+
+	// Width and height of the world, it's centered on the origin
+	DefaultWorldSize = 6000
+
+	// How often hazards and power-ups are topped up, spores have their own spawner
+	replenishInterval = 5 * time.Second
+)
+
+// The World is the single authority over the game objects. Players, spores and
+// their interactions are only ever mutated from the world goroutine, so every
+// client state feeds its inputs in through the world instead of touching the
+// objects directly.
+type World struct {
+	hub     *Hub
+	objects *SharedGameObjects
+	mode    GameMode
+	logger  *log.Logger
+	dbTx    *DbTx
+
+	tickRate       int
+	sinceReplenish time.Duration
+	sporeSpawner   *sporeSpawner
+	gameMap        *objects.Map
+	bounds         objects.Bounds
+	inertia        time.Duration
+	massDecayRate  float64
+	maxRadius      float64
+	sporeWeights   SporeWeights
+
+	// Rounds are disabled when the duration is 0, the game then only ends through the mode
+	roomName             string
+	roundDuration        time.Duration
+	roundStartedAt       time.Time
+	roundLeft            time.Duration
+	announcedSecondsLeft int
+
+	// What each player or spectator currently has in view, keyed by their ID
+	interests map[uint64]*interest
+
+	// Clients watching the game without a player of their own
+	spectators map[uint64]*spectator
+
+	// Ejected spores that haven't come to rest yet
+	movingSpores map[uint64]*objects.Spore
+
+	commands    []func()
+	commandsMux sync.Mutex
+
+	// Database writes waiting for the writer goroutine
+	dbWrites chan func()
+
+	stopChan chan struct{}
+}
+
+func NewWorld(hub *Hub, config RoomConfig, mode GameMode) *World {
+	tickRate := config.TickRate
+	if tickRate <= 0 {
+		tickRate = DefaultTickRate
+	}
+
+	sporeWeights := config.SporeWeights
+	if sporeWeights.total() <= 0 {
+		sporeWeights = DefaultSporeWeights
+	}
+
+	maxRadius := config.MaxRadius
+	if maxRadius <= 0 {
+		maxRadius = DefaultMaxRadius
+	}
+
+	gameMap := config.Map
+	if gameMap == nil {
+		width, height := config.WorldWidth, config.WorldHeight
+		if width <= 0 || height <= 0 {
+			width, height = DefaultWorldSize, DefaultWorldSize
+		}
+		gameMap = objects.NewEmptyMap(float64(width), float64(height))
+	}
+
+	return &World{
+		hub: hub,
+		objects: &SharedGameObjects{
+			Players:  objects.NewPlayerCollection(),
+			Spores:   objects.NewSporeCollection(),
+			Cells:    objects.NewCellCollection(),
+			Hazards:  objects.NewHazardCollection(),
+			PowerUps: objects.NewPowerUpCollection(),
+		},
+		mode:          mode,
+		logger:        log.New(log.Writer(), "World: ", log.LstdFlags),
+		dbTx:          hub.NewDbTx(),
+		tickRate:      tickRate,
+		gameMap:       gameMap,
+		bounds:        gameMap.Bounds(),
+		sporeSpawner:  newSporeSpawner(gameMap),
+		inertia:       config.Inertia,
+		massDecayRate: config.MassDecayRate,
+		maxRadius:     maxRadius,
+		sporeWeights:  sporeWeights,
+		roundDuration: config.RoundDuration,
+		interests:     make(map[uint64]*interest),
+		spectators:    make(map[uint64]*spectator),
+		movingSpores:  make(map[uint64]*objects.Spore),
+		dbWrites:      make(chan func(), dbWriteBacklog),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+func (w *World) Run() {
+	interval := time.Second / time.Duration(w.tickRate)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	w.logger.Printf("Running simulation at %d ticks per second", w.tickRate)
+	go w.runDbWrites()
+	w.startRound()
+	for {
+		select {
+		case <-ticker.C:
+			w.tick(interval)
+		case <-w.stopChan:
+			w.logger.Println("Simulation stopped")
+			// Let the writer finish what's queued and then exit
+			close(w.dbWrites)
+			return
+		}
+	}
+}
+
+// Stop the simulation loop, must only be called once
+func (w *World) Stop() {
+	close(w.stopChan)
+}
+
+// Add the player to the world at the start of the next tick
+func (w *World) Join(playerId uint64, player *objects.Player) {
+	w.enqueue(func() {
+		w.mode.OnPlayerJoin(w, playerId, player)
+		player.X, player.Y = w.mode.SpawnPosition(w, player)
+		protectPlayer(player)
+		resetSessionStats(player)
+		w.objects.Players.Add(player, playerId)
+		w.interests[playerId] = newInterest()
+		w.sendRoundCountdown(playerId)
+	})
+}
+
+// Start showing the game to a client that isn't playing, at the start of the next tick
+func (w *World) Spectate(clientId uint64) {
+	w.enqueue(func() {
+		w.spectators[clientId] = &spectator{}
+		w.interests[clientId] = newInterest()
+		w.sendRoundCountdown(clientId)
+	})
+}
+
+// Remove the player or spectator from the world at the start of the next tick
+func (w *World) Leave(playerId uint64) {
+	w.enqueue(func() {
+		if _, isSpectator := w.spectators[playerId]; isSpectator {
+			delete(w.spectators, playerId)
+			delete(w.interests, playerId)
+			return
+		}
+
+		player, exists := w.objects.Players.Get(playerId)
+		if !exists {
+			return
+		}
+
+		w.syncPlayerBestScore(player)
+		w.removeCells(player)
+		w.objects.Players.Remove(playerId)
+		delete(w.interests, playerId)
+		w.mode.OnPlayerLeave(w, playerId)
+	})
+}
+
+// Queue an input message sent by the player, to be applied at the start of the next tick
+func (w *World) QueueInput(playerId uint64, msg packets.Msg) {
+	w.enqueue(func() {
+		// Spectators acknowledge snapshots too, so this doesn't need a player
+		if ack, isAck := msg.(*packets.Packet_SnapshotAck); isAck {
+			w.acknowledgeSnapshot(playerId, ack.SnapshotAck.Sequence)
+			return
+		}
+
+		if s, isSpectator := w.spectators[playerId]; isSpectator {
+			w.handleSpectatorInput(s, msg)
+			return
+		}
+
+		player, exists := w.objects.Players.Get(playerId)
+		if !exists {
+			return
+		}
+
+		w.handleInput(playerId, player, msg)
+	})
+}
+
+func (w *World) enqueue(command func()) {
+	w.commandsMux.Lock()
+	defer w.commandsMux.Unlock()
+
+	w.commands = append(w.commands, command)
+}
+
+func (w *World) runCommands() {
+	w.commandsMux.Lock()
+	commands := w.commands
+	w.commands = nil
+	w.commandsMux.Unlock()
+
+	for _, command := range commands {
+		command()
+	}
+}
+
+func (w *World) tick(interval time.Duration) {
+	w.runCommands()
+
+	delta := interval.Seconds()
+	w.objects.Players.ForEach(func(_ uint64, player *objects.Player) {
+		w.pullSpores(player)
+	})
+	w.moveSpores(delta)
+	w.objects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		w.movePlayer(player, delta)
+		w.moveCells(player, delta)
+		w.dropSpore(player)
+		w.decayPlayer(player, delta)
+		w.expireEffects(playerId, player)
+		expireSpawnProtection(player)
+		w.reindexPlayer(playerId, player)
+	})
+
+	// Resolve collisions only once everyone has moved, so the outcome doesn't depend on the update order
+	w.objects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		if _, exists := w.objects.Players.Get(playerId); !exists {
+			return
+		}
+
+		for _, eater := range bodies(player) {
+			w.consumeSpores(playerId, player, eater)
+			w.consumePlayers(playerId, player, eater)
+			w.consumeCells(playerId, player, eater)
+			w.hitHazards(playerId, player, eater)
+			w.collectPowerUps(playerId, player, eater)
+		}
+
+		w.mergeCells(player)
+		w.capPlayerSize(player)
+		trackPeakMass(player)
+		w.reindexPlayer(playerId, player)
+	})
+
+	w.tickSporeSpawner(delta)
+
+	w.sinceReplenish += interval
+	if w.sinceReplenish >= replenishInterval {
+		w.sinceReplenish = 0
+		w.replenishHazards(hazardReplenishBatch)
+		w.replenishPowerUps(powerUpReplenishBatch)
+	}
+
+	w.mode.Tick(w, delta)
+	w.tickRound(interval)
+
+	w.objects.Players.ForEach(func(playerId uint64, player *objects.Player) {
+		w.updateInterest(playerId, player)
+	})
+
+	w.updateSpectators()
+}
+
+func (w *World) handleInput(playerId uint64, player *objects.Player, msg packets.Msg) {
+	switch msg := msg.(type) {
+	case *packets.Packet_PlayerDirection:
+		player.Direction = msg.PlayerDirection.Direction
+		player.HasTarget = false
+	case *packets.Packet_PlayerTarget:
+		player.TargetX, player.TargetY = w.bounds.Clamp(msg.PlayerTarget.X, msg.PlayerTarget.Y, 0)
+		player.HasTarget = true
+	case *packets.Packet_PlayerSplit:
+		w.splitPlayer(playerId, player)
+	case *packets.Packet_PlayerEject:
+		w.ejectMass(player)
+	}
+}
+
+func (w *World) consumeSpores(playerId uint64, player *objects.Player, eater objects.Body) {
+	x, y := eater.Position()
+	w.objects.Spores.QueryRadius(x, y, eater.Size(), func(sporeId uint64, spore *objects.Spore) {
+		if !w.mode.CanConsumeSpore(player, spore) {
+			return
+		}
+
+		eater.Grow(sporeMassGain(spore, eater.Size()))
+		player.SporesEaten++
+		w.objects.Spores.Remove(sporeId)
+		w.notifySporeConsumed(playerId, sporeId)
+		w.syncPlayerBestScore(player)
+	})
+}
+
+func (w *World) consumePlayers(playerId uint64, player *objects.Player, eater objects.Body) {
+	x, y := eater.Position()
+	w.objects.Players.QueryRadius(x, y, eater.Size(), func(otherId uint64, other *objects.Player) {
+		if otherId == playerId {
+			return
+		}
+
+		// The other player might have been eaten already during this pass
+		if _, exists := w.objects.Players.Get(otherId); !exists {
+			return
+		}
+
+		if !w.canEat(player, eater, other, other) {
+			return
+		}
+
+		eater.Grow(objects.RadToMass(other.Radius))
+		w.syncPlayerBestScore(player)
+
+		if w.promoteCell(otherId, other) {
+			return
+		}
+
+		player.Kills++
+		other.KillerId, other.KillerName = playerId, player.Name
+		w.objects.Players.Remove(otherId)
+		delete(w.interests, otherId)
+		w.mode.OnPlayerConsumed(w, playerId, otherId)
+		w.notifyPlayerConsumed(playerId, otherId)
+	})
+}
+
+func (w *World) dropSpore(player *objects.Player) {
+	if rand.Float64() >= w.mode.SporeDropProbability(player) {
+		return
+	}
+
+	spore := &objects.Spore{
+		X:         player.X,
+		Y:         player.Y,
+		Radius:    min(5+player.Radius/50, 15),
+		DroppedBy: player,
+		DroppedAt: time.Now(),
+	}
+	w.objects.Spores.Add(spore)
+	player.Radius = objects.NextRadius(player.Radius, -objects.RadToMass(spore.Radius))
+}
+
+// Take the player out of the world without anyone eating them, e.g. when the game mode eliminates them
+func (w *World) eliminatePlayer(playerId uint64) {
+	player, exists := w.objects.Players.Get(playerId)
+	if !exists {
+		return
+	}
+
+	w.syncPlayerBestScore(player)
+	w.removeCells(player)
+	w.objects.Players.Remove(playerId)
+	delete(w.interests, playerId)
+	w.notifyPlayerConsumed(0, playerId)
+}
+
+// Announce the winner to everyone in the world and start the next round
+func (w *World) EndGame(winnerId uint64) {
+	winnerName := ""
+	if winner, exists := w.objects.Players.Get(winnerId); exists {
+		winnerName = winner.Name
+	}
+
+	w.logger.Printf("Game over, %s (%d) won the %s game", winnerName, winnerId, w.mode.Name())
+	w.broadcastToPlayers(packets.NewGameOver(winnerId, winnerName))
+	w.endRound()
+}
+
+func (w *World) newSpore(area objects.Bounds) *objects.Spore {
+	return w.newSporeOfKind(area, w.sporeWeights.pick())
+}
+
+func (w *World) newSporeOfKind(area objects.Bounds, kind objects.SporeKind) *objects.Spore {
+	sporeRadius := max(10+rand.NormFloat64()*3, 5)
+	x, y := objects.SpawnCoords(sporeRadius, area, w.gameMap, w.objects.Players, w.objects.Spores, w.objects.Hazards)
+	return &objects.Spore{
+		X:      x,
+		Y:      y,
+		Radius: sporeRadius,
+		Kind:   kind,
+	}
+}
+
+func (w *World) syncPlayerBestScore(player *objects.Player) {
+	currentScore := w.mode.Score(player)
+	if currentScore <= player.BestScore {
+		return
+	}
+
+	player.BestScore = currentScore
+	params := db.UpdatePlayerBestScoreParams{
+		ID:        player.DbId,
+		BestScore: currentScore,
+	}
+
+	w.writeToDb(func() {
+		if err := w.dbTx.Queries.UpdatePlayerBestScore(w.dbTx.Ctx, params); err != nil {
+			w.logger.Printf("Error updating player best score: %v", err)
+		}
+	})
+}
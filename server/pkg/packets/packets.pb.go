@@ -21,6 +21,111 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SporeKind int32
+
+const (
+	SporeKind_SPORE_COMMON SporeKind = 0
+	// Worth several times its size
+	SporeKind_SPORE_GOLDEN SporeKind = 1
+	// Takes mass away instead of giving it
+	SporeKind_SPORE_POISON SporeKind = 2
+)
+
+// Enum value maps for SporeKind.
+var (
+	SporeKind_name = map[int32]string{
+		0: "SPORE_COMMON",
+		1: "SPORE_GOLDEN",
+		2: "SPORE_POISON",
+	}
+	SporeKind_value = map[string]int32{
+		"SPORE_COMMON": 0,
+		"SPORE_GOLDEN": 1,
+		"SPORE_POISON": 2,
+	}
+)
+
+func (x SporeKind) Enum() *SporeKind {
+	p := new(SporeKind)
+	*p = x
+	return p
+}
+
+func (x SporeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SporeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[0].Descriptor()
+}
+
+func (SporeKind) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[0]
+}
+
+func (x SporeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SporeKind.Descriptor instead.
+func (SporeKind) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{0}
+}
+
+type PowerUpKind int32
+
+const (
+	PowerUpKind_POWER_UP_NONE  PowerUpKind = 0
+	PowerUpKind_POWER_UP_SPEED PowerUpKind = 1
+	// Can't be eaten while it lasts
+	PowerUpKind_POWER_UP_SHIELD PowerUpKind = 2
+	// Pulls in nearby spores
+	PowerUpKind_POWER_UP_MAGNET PowerUpKind = 3
+)
+
+// Enum value maps for PowerUpKind.
+var (
+	PowerUpKind_name = map[int32]string{
+		0: "POWER_UP_NONE",
+		1: "POWER_UP_SPEED",
+		2: "POWER_UP_SHIELD",
+		3: "POWER_UP_MAGNET",
+	}
+	PowerUpKind_value = map[string]int32{
+		"POWER_UP_NONE":   0,
+		"POWER_UP_SPEED":  1,
+		"POWER_UP_SHIELD": 2,
+		"POWER_UP_MAGNET": 3,
+	}
+)
+
+func (x PowerUpKind) Enum() *PowerUpKind {
+	p := new(PowerUpKind)
+	*p = x
+	return p
+}
+
+func (x PowerUpKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PowerUpKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_packets_proto_enumTypes[1].Descriptor()
+}
+
+func (PowerUpKind) Type() protoreflect.EnumType {
+	return &file_packets_proto_enumTypes[1]
+}
+
+func (x PowerUpKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PowerUpKind.Descriptor instead.
+func (PowerUpKind) EnumDescriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{1}
+}
+
 type ChatMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Msg           string                 `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -302,17 +407,23 @@ func (x *DenyResponseMessage) GetMsg() string {
 }
 
 type PlayerMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	X             float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
-	Radius        float64                `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
-	Direction     float64                `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
-	Speed         float64                `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
-	Color         uint32                 `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	X         float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y         float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
+	Radius    float64                `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	Direction float64                `protobuf:"fixed64,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	Speed     float64                `protobuf:"fixed64,7,opt,name=speed,proto3" json:"speed,omitempty"`
+	Color     uint32                 `protobuf:"varint,8,opt,name=color,proto3" json:"color,omitempty"`
+	Team      uint32                 `protobuf:"varint,9,opt,name=team,proto3" json:"team,omitempty"`
+	// Where the player is actually heading, which lags behind direction while they turn
+	VelocityX float64 `protobuf:"fixed64,10,opt,name=velocity_x,json=velocityX,proto3" json:"velocity_x,omitempty"`
+	VelocityY float64 `protobuf:"fixed64,11,opt,name=velocity_y,json=velocityY,proto3" json:"velocity_y,omitempty"`
+	// Just spawned, nobody can eat them yet
+	SpawnProtected bool `protobuf:"varint,12,opt,name=spawn_protected,json=spawnProtected,proto3" json:"spawn_protected,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PlayerMessage) Reset() {
@@ -401,6 +512,34 @@ func (x *PlayerMessage) GetColor() uint32 {
 	return 0
 }
 
+func (x *PlayerMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetVelocityX() float64 {
+	if x != nil {
+		return x.VelocityX
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetVelocityY() float64 {
+	if x != nil {
+		return x.VelocityY
+	}
+	return 0
+}
+
+func (x *PlayerMessage) GetSpawnProtected() bool {
+	if x != nil {
+		return x.SpawnProtected
+	}
+	return false
+}
+
 type PlayerDirectionMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Direction     float64                `protobuf:"fixed64,2,opt,name=direction,proto3" json:"direction,omitempty"`
@@ -445,19 +584,145 @@ func (x *PlayerDirectionMessage) GetDirection() float64 {
 	return 0
 }
 
+// Head for a point in the world, slowing down on the way in and stopping there. Sending a direction again cancels it.
+type PlayerTargetMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerTargetMessage) Reset() {
+	*x = PlayerTargetMessage{}
+	mi := &file_packets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerTargetMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerTargetMessage) ProtoMessage() {}
+
+func (x *PlayerTargetMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerTargetMessage.ProtoReflect.Descriptor instead.
+func (*PlayerTargetMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerTargetMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PlayerTargetMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type PlayerSplitMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSplitMessage) Reset() {
+	*x = PlayerSplitMessage{}
+	mi := &file_packets_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSplitMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSplitMessage) ProtoMessage() {}
+
+func (x *PlayerSplitMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSplitMessage.ProtoReflect.Descriptor instead.
+func (*PlayerSplitMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{9}
+}
+
+type PlayerEjectMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerEjectMessage) Reset() {
+	*x = PlayerEjectMessage{}
+	mi := &file_packets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerEjectMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerEjectMessage) ProtoMessage() {}
+
+func (x *PlayerEjectMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerEjectMessage.ProtoReflect.Descriptor instead.
+func (*PlayerEjectMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{10}
+}
+
 type SporeMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
 	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
 	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Kind          SporeKind              `protobuf:"varint,5,opt,name=kind,proto3,enum=packets.SporeKind" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SporeMessage) Reset() {
 	*x = SporeMessage{}
-	mi := &file_packets_proto_msgTypes[8]
+	mi := &file_packets_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +734,7 @@ func (x *SporeMessage) String() string {
 func (*SporeMessage) ProtoMessage() {}
 
 func (x *SporeMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[8]
+	mi := &file_packets_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +747,7 @@ func (x *SporeMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeMessage.ProtoReflect.Descriptor instead.
 func (*SporeMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{8}
+	return file_packets_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SporeMessage) GetId() uint64 {
@@ -513,6 +778,13 @@ func (x *SporeMessage) GetRadius() float64 {
 	return 0
 }
 
+func (x *SporeMessage) GetKind() SporeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SporeKind_SPORE_COMMON
+}
+
 type SporeConsumedMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SporeId       uint64                 `protobuf:"varint,1,opt,name=spore_id,json=sporeId,proto3" json:"spore_id,omitempty"`
@@ -522,7 +794,7 @@ type SporeConsumedMessage struct {
 
 func (x *SporeConsumedMessage) Reset() {
 	*x = SporeConsumedMessage{}
-	mi := &file_packets_proto_msgTypes[9]
+	mi := &file_packets_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +806,7 @@ func (x *SporeConsumedMessage) String() string {
 func (*SporeConsumedMessage) ProtoMessage() {}
 
 func (x *SporeConsumedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[9]
+	mi := &file_packets_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +819,7 @@ func (x *SporeConsumedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeConsumedMessage.ProtoReflect.Descriptor instead.
 func (*SporeConsumedMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{9}
+	return file_packets_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SporeConsumedMessage) GetSporeId() uint64 {
@@ -566,7 +838,7 @@ type SporeBatchMessage struct {
 
 func (x *SporeBatchMessage) Reset() {
 	*x = SporeBatchMessage{}
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -578,7 +850,7 @@ func (x *SporeBatchMessage) String() string {
 func (*SporeBatchMessage) ProtoMessage() {}
 
 func (x *SporeBatchMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[10]
+	mi := &file_packets_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,7 +863,7 @@ func (x *SporeBatchMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SporeBatchMessage.ProtoReflect.Descriptor instead.
 func (*SporeBatchMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{10}
+	return file_packets_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SporeBatchMessage) GetSpores() []*SporeMessage {
@@ -610,7 +882,7 @@ type PlayerConsumedMessage struct {
 
 func (x *PlayerConsumedMessage) Reset() {
 	*x = PlayerConsumedMessage{}
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +894,7 @@ func (x *PlayerConsumedMessage) String() string {
 func (*PlayerConsumedMessage) ProtoMessage() {}
 
 func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[11]
+	mi := &file_packets_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +907,7 @@ func (x *PlayerConsumedMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerConsumedMessage.ProtoReflect.Descriptor instead.
 func (*PlayerConsumedMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{11}
+	return file_packets_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PlayerConsumedMessage) GetPlayerId() uint64 {
@@ -653,7 +925,7 @@ type HiscoreBoardRequestMessage struct {
 
 func (x *HiscoreBoardRequestMessage) Reset() {
 	*x = HiscoreBoardRequestMessage{}
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +937,7 @@ func (x *HiscoreBoardRequestMessage) String() string {
 func (*HiscoreBoardRequestMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[12]
+	mi := &file_packets_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +950,7 @@ func (x *HiscoreBoardRequestMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardRequestMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardRequestMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{12}
+	return file_packets_proto_rawDescGZIP(), []int{15}
 }
 
 type HiscoreMessage struct {
@@ -692,7 +964,7 @@ type HiscoreMessage struct {
 
 func (x *HiscoreMessage) Reset() {
 	*x = HiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -704,7 +976,7 @@ func (x *HiscoreMessage) String() string {
 func (*HiscoreMessage) ProtoMessage() {}
 
 func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[13]
+	mi := &file_packets_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,7 +989,7 @@ func (x *HiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{13}
+	return file_packets_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HiscoreMessage) GetRank() uint64 {
@@ -750,7 +1022,7 @@ type HiscoreBoardMessage struct {
 
 func (x *HiscoreBoardMessage) Reset() {
 	*x = HiscoreBoardMessage{}
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +1034,7 @@ func (x *HiscoreBoardMessage) String() string {
 func (*HiscoreBoardMessage) ProtoMessage() {}
 
 func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[14]
+	mi := &file_packets_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1047,7 @@ func (x *HiscoreBoardMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HiscoreBoardMessage.ProtoReflect.Descriptor instead.
 func (*HiscoreBoardMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{14}
+	return file_packets_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *HiscoreBoardMessage) GetHiscores() []*HiscoreMessage {
@@ -793,7 +1065,7 @@ type FinishedBrowsingHiscoresMessage struct {
 
 func (x *FinishedBrowsingHiscoresMessage) Reset() {
 	*x = FinishedBrowsingHiscoresMessage{}
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -805,7 +1077,7 @@ func (x *FinishedBrowsingHiscoresMessage) String() string {
 func (*FinishedBrowsingHiscoresMessage) ProtoMessage() {}
 
 func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[15]
+	mi := &file_packets_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1090,7 @@ func (x *FinishedBrowsingHiscoresMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishedBrowsingHiscoresMessage.ProtoReflect.Descriptor instead.
 func (*FinishedBrowsingHiscoresMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{15}
+	return file_packets_proto_rawDescGZIP(), []int{18}
 }
 
 type SearchHiscoreMessage struct {
@@ -830,7 +1102,7 @@ type SearchHiscoreMessage struct {
 
 func (x *SearchHiscoreMessage) Reset() {
 	*x = SearchHiscoreMessage{}
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +1114,7 @@ func (x *SearchHiscoreMessage) String() string {
 func (*SearchHiscoreMessage) ProtoMessage() {}
 
 func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[16]
+	mi := &file_packets_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1127,7 @@ func (x *SearchHiscoreMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchHiscoreMessage.ProtoReflect.Descriptor instead.
 func (*SearchHiscoreMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{16}
+	return file_packets_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SearchHiscoreMessage) GetName() string {
@@ -874,7 +1146,7 @@ type DisconnectMessage struct {
 
 func (x *DisconnectMessage) Reset() {
 	*x = DisconnectMessage{}
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -886,7 +1158,7 @@ func (x *DisconnectMessage) String() string {
 func (*DisconnectMessage) ProtoMessage() {}
 
 func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[17]
+	mi := &file_packets_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1171,7 @@ func (x *DisconnectMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DisconnectMessage.ProtoReflect.Descriptor instead.
 func (*DisconnectMessage) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{17}
+	return file_packets_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DisconnectMessage) GetReason() string {
@@ -909,235 +1181,2742 @@ func (x *DisconnectMessage) GetReason() string {
 	return ""
 }
 
-type Packet struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	// Types that are valid to be assigned to Msg:
-	//
-	//	*Packet_Chat
-	//	*Packet_Id
-	//	*Packet_LoginRequest
-	//	*Packet_RegisterRequest
-	//	*Packet_OkResponse
-	//	*Packet_DenyResponse
-	//	*Packet_Player
-	//	*Packet_PlayerDirection
-	//	*Packet_Spore
-	//	*Packet_SporeConsumed
-	//	*Packet_SporeBatch
-	//	*Packet_PlayerConsumed
-	//	*Packet_HiscoreBoardRequest
-	//	*Packet_Hiscore
-	//	*Packet_HiscoreBoard
-	//	*Packet_FinishBrowsingHiscores
-	//	*Packet_SearchHiscore
-	//	*Packet_Disconnect
-	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+// Objects that came into view, spores still in view are sent again while they're moving to update their position
+type ViewEnterMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spores        []*SporeMessage        `protobuf:"bytes,2,rep,name=spores,proto3" json:"spores,omitempty"`
+	Hazards       []*HazardMessage       `protobuf:"bytes,3,rep,name=hazards,proto3" json:"hazards,omitempty"`
+	PowerUps      []*PowerUpMessage      `protobuf:"bytes,4,rep,name=power_ups,json=powerUps,proto3" json:"power_ups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Packet) Reset() {
-	*x = Packet{}
-	mi := &file_packets_proto_msgTypes[18]
+func (x *ViewEnterMessage) Reset() {
+	*x = ViewEnterMessage{}
+	mi := &file_packets_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Packet) String() string {
+func (x *ViewEnterMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Packet) ProtoMessage() {}
+func (*ViewEnterMessage) ProtoMessage() {}
 
-func (x *Packet) ProtoReflect() protoreflect.Message {
-	mi := &file_packets_proto_msgTypes[18]
+func (x *ViewEnterMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewEnterMessage.ProtoReflect.Descriptor instead.
+func (*ViewEnterMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ViewEnterMessage) GetSpores() []*SporeMessage {
+	if x != nil {
+		return x.Spores
+	}
+	return nil
+}
+
+func (x *ViewEnterMessage) GetHazards() []*HazardMessage {
+	if x != nil {
+		return x.Hazards
+	}
+	return nil
+}
+
+func (x *ViewEnterMessage) GetPowerUps() []*PowerUpMessage {
+	if x != nil {
+		return x.PowerUps
+	}
+	return nil
+}
+
+type ViewLeaveMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SporeIds      []uint64               `protobuf:"varint,2,rep,packed,name=spore_ids,json=sporeIds,proto3" json:"spore_ids,omitempty"`
+	HazardIds     []uint64               `protobuf:"varint,3,rep,packed,name=hazard_ids,json=hazardIds,proto3" json:"hazard_ids,omitempty"`
+	PowerUpIds    []uint64               `protobuf:"varint,4,rep,packed,name=power_up_ids,json=powerUpIds,proto3" json:"power_up_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewLeaveMessage) Reset() {
+	*x = ViewLeaveMessage{}
+	mi := &file_packets_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewLeaveMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewLeaveMessage) ProtoMessage() {}
+
+func (x *ViewLeaveMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewLeaveMessage.ProtoReflect.Descriptor instead.
+func (*ViewLeaveMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ViewLeaveMessage) GetSporeIds() []uint64 {
+	if x != nil {
+		return x.SporeIds
+	}
+	return nil
+}
+
+func (x *ViewLeaveMessage) GetHazardIds() []uint64 {
+	if x != nil {
+		return x.HazardIds
+	}
+	return nil
+}
+
+func (x *ViewLeaveMessage) GetPowerUpIds() []uint64 {
+	if x != nil {
+		return x.PowerUpIds
+	}
+	return nil
+}
+
+type HazardMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HazardMessage) Reset() {
+	*x = HazardMessage{}
+	mi := &file_packets_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HazardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HazardMessage) ProtoMessage() {}
+
+func (x *HazardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HazardMessage.ProtoReflect.Descriptor instead.
+func (*HazardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HazardMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HazardMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *HazardMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *HazardMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+// The hazard burst the player who ran into it, and is gone
+type HazardBurstMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HazardId      uint64                 `protobuf:"varint,1,opt,name=hazard_id,json=hazardId,proto3" json:"hazard_id,omitempty"`
+	PlayerId      uint64                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HazardBurstMessage) Reset() {
+	*x = HazardBurstMessage{}
+	mi := &file_packets_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HazardBurstMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HazardBurstMessage) ProtoMessage() {}
+
+func (x *HazardBurstMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HazardBurstMessage.ProtoReflect.Descriptor instead.
+func (*HazardBurstMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *HazardBurstMessage) GetHazardId() uint64 {
+	if x != nil {
+		return x.HazardId
+	}
+	return 0
+}
+
+func (x *HazardBurstMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type PowerUpMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,4,opt,name=radius,proto3" json:"radius,omitempty"`
+	Kind          PowerUpKind            `protobuf:"varint,5,opt,name=kind,proto3,enum=packets.PowerUpKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpMessage) Reset() {
+	*x = PowerUpMessage{}
+	mi := &file_packets_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpMessage) ProtoMessage() {}
+
+func (x *PowerUpMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PowerUpMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *PowerUpMessage) GetKind() PowerUpKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowerUpKind_POWER_UP_NONE
+}
+
+type PowerUpCollectedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PowerUpId     uint64                 `protobuf:"varint,1,opt,name=power_up_id,json=powerUpId,proto3" json:"power_up_id,omitempty"`
+	PlayerId      uint64                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Kind          PowerUpKind            `protobuf:"varint,3,opt,name=kind,proto3,enum=packets.PowerUpKind" json:"kind,omitempty"`
+	DurationMs    uint32                 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpCollectedMessage) Reset() {
+	*x = PowerUpCollectedMessage{}
+	mi := &file_packets_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpCollectedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpCollectedMessage) ProtoMessage() {}
+
+func (x *PowerUpCollectedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpCollectedMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpCollectedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PowerUpCollectedMessage) GetPowerUpId() uint64 {
+	if x != nil {
+		return x.PowerUpId
+	}
+	return 0
+}
+
+func (x *PowerUpCollectedMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PowerUpCollectedMessage) GetKind() PowerUpKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowerUpKind_POWER_UP_NONE
+}
+
+func (x *PowerUpCollectedMessage) GetDurationMs() uint32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type PowerUpExpiredMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Kind          PowerUpKind            `protobuf:"varint,2,opt,name=kind,proto3,enum=packets.PowerUpKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PowerUpExpiredMessage) Reset() {
+	*x = PowerUpExpiredMessage{}
+	mi := &file_packets_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PowerUpExpiredMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerUpExpiredMessage) ProtoMessage() {}
+
+func (x *PowerUpExpiredMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerUpExpiredMessage.ProtoReflect.Descriptor instead.
+func (*PowerUpExpiredMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PowerUpExpiredMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PowerUpExpiredMessage) GetKind() PowerUpKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PowerUpKind_POWER_UP_NONE
+}
+
+// Only the fields that changed since the baseline are set, every field is set for players new to the baseline
+type PlayerDeltaMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	X              *float64               `protobuf:"fixed64,3,opt,name=x,proto3,oneof" json:"x,omitempty"`
+	Y              *float64               `protobuf:"fixed64,4,opt,name=y,proto3,oneof" json:"y,omitempty"`
+	Radius         *float64               `protobuf:"fixed64,5,opt,name=radius,proto3,oneof" json:"radius,omitempty"`
+	Direction      *float64               `protobuf:"fixed64,6,opt,name=direction,proto3,oneof" json:"direction,omitempty"`
+	Speed          *float64               `protobuf:"fixed64,7,opt,name=speed,proto3,oneof" json:"speed,omitempty"`
+	Color          *uint32                `protobuf:"varint,8,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	Team           *uint32                `protobuf:"varint,9,opt,name=team,proto3,oneof" json:"team,omitempty"`
+	VelocityX      *float64               `protobuf:"fixed64,10,opt,name=velocity_x,json=velocityX,proto3,oneof" json:"velocity_x,omitempty"`
+	VelocityY      *float64               `protobuf:"fixed64,11,opt,name=velocity_y,json=velocityY,proto3,oneof" json:"velocity_y,omitempty"`
+	SpawnProtected *bool                  `protobuf:"varint,12,opt,name=spawn_protected,json=spawnProtected,proto3,oneof" json:"spawn_protected,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PlayerDeltaMessage) Reset() {
+	*x = PlayerDeltaMessage{}
+	mi := &file_packets_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerDeltaMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDeltaMessage) ProtoMessage() {}
+
+func (x *PlayerDeltaMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDeltaMessage.ProtoReflect.Descriptor instead.
+func (*PlayerDeltaMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PlayerDeltaMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *PlayerDeltaMessage) GetX() float64 {
+	if x != nil && x.X != nil {
+		return *x.X
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetY() float64 {
+	if x != nil && x.Y != nil {
+		return *x.Y
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetRadius() float64 {
+	if x != nil && x.Radius != nil {
+		return *x.Radius
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetDirection() float64 {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetSpeed() float64 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetColor() uint32 {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetTeam() uint32 {
+	if x != nil && x.Team != nil {
+		return *x.Team
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetVelocityX() float64 {
+	if x != nil && x.VelocityX != nil {
+		return *x.VelocityX
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetVelocityY() float64 {
+	if x != nil && x.VelocityY != nil {
+		return *x.VelocityY
+	}
+	return 0
+}
+
+func (x *PlayerDeltaMessage) GetSpawnProtected() bool {
+	if x != nil && x.SpawnProtected != nil {
+		return *x.SpawnProtected
+	}
+	return false
+}
+
+// A piece a player split off, it belongs to the player with ID owner_id
+type CellMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       uint64                 `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	X             float64                `protobuf:"fixed64,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,4,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CellMessage) Reset() {
+	*x = CellMessage{}
+	mi := &file_packets_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CellMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellMessage) ProtoMessage() {}
+
+func (x *CellMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellMessage.ProtoReflect.Descriptor instead.
+func (*CellMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CellMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CellMessage) GetOwnerId() uint64 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CellMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *CellMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *CellMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+// Players in view, relative to the snapshot with sequence number baseline (0 for a keyframe).
+// Split off cells in view are always sent in full.
+type SnapshotMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Sequence         uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Baseline         uint64                 `protobuf:"varint,2,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	Players          []*PlayerDeltaMessage  `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	RemovedPlayerIds []uint64               `protobuf:"varint,4,rep,packed,name=removed_player_ids,json=removedPlayerIds,proto3" json:"removed_player_ids,omitempty"`
+	Cells            []*CellMessage         `protobuf:"bytes,5,rep,name=cells,proto3" json:"cells,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SnapshotMessage) Reset() {
+	*x = SnapshotMessage{}
+	mi := &file_packets_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotMessage) ProtoMessage() {}
+
+func (x *SnapshotMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotMessage.ProtoReflect.Descriptor instead.
+func (*SnapshotMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SnapshotMessage) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SnapshotMessage) GetBaseline() uint64 {
+	if x != nil {
+		return x.Baseline
+	}
+	return 0
+}
+
+func (x *SnapshotMessage) GetPlayers() []*PlayerDeltaMessage {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *SnapshotMessage) GetRemovedPlayerIds() []uint64 {
+	if x != nil {
+		return x.RemovedPlayerIds
+	}
+	return nil
+}
+
+func (x *SnapshotMessage) GetCells() []*CellMessage {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type SnapshotAckMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotAckMessage) Reset() {
+	*x = SnapshotAckMessage{}
+	mi := &file_packets_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotAckMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotAckMessage) ProtoMessage() {}
+
+func (x *SnapshotAckMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotAckMessage.ProtoReflect.Descriptor instead.
+func (*SnapshotAckMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SnapshotAckMessage) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type RoomMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Players       uint32                 `protobuf:"varint,3,opt,name=players,proto3" json:"players,omitempty"`
+	Capacity      uint32                 `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomMessage) Reset() {
+	*x = RoomMessage{}
+	mi := &file_packets_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomMessage) ProtoMessage() {}
+
+func (x *RoomMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomMessage.ProtoReflect.Descriptor instead.
+func (*RoomMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RoomMessage) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RoomMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoomMessage) GetPlayers() uint32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+func (x *RoomMessage) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type RoomListRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomListRequestMessage) Reset() {
+	*x = RoomListRequestMessage{}
+	mi := &file_packets_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomListRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomListRequestMessage) ProtoMessage() {}
+
+func (x *RoomListRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomListRequestMessage.ProtoReflect.Descriptor instead.
+func (*RoomListRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{33}
+}
+
+type RoomListMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*RoomMessage         `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomListMessage) Reset() {
+	*x = RoomListMessage{}
+	mi := &file_packets_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomListMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomListMessage) ProtoMessage() {}
+
+func (x *RoomListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomListMessage.ProtoReflect.Descriptor instead.
+func (*RoomListMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RoomListMessage) GetRooms() []*RoomMessage {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// Pick the room to play in after logging in, 0 leaves it up to matchmaking
+type JoinRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomRequestMessage) Reset() {
+	*x = JoinRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequestMessage) ProtoMessage() {}
+
+func (x *JoinRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *JoinRoomRequestMessage) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type CreateRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      uint32                 `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequestMessage) Reset() {
+	*x = CreateRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequestMessage) ProtoMessage() {}
+
+func (x *CreateRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateRoomRequestMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequestMessage) GetCapacity() uint32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type RoomCreatedMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomCreatedMessage) Reset() {
+	*x = RoomCreatedMessage{}
+	mi := &file_packets_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomCreatedMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomCreatedMessage) ProtoMessage() {}
+
+func (x *RoomCreatedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomCreatedMessage.ProtoReflect.Descriptor instead.
+func (*RoomCreatedMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RoomCreatedMessage) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *RoomCreatedMessage) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type JoinRoomByCodeRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomByCodeRequestMessage) Reset() {
+	*x = JoinRoomByCodeRequestMessage{}
+	mi := &file_packets_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomByCodeRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomByCodeRequestMessage) ProtoMessage() {}
+
+func (x *JoinRoomByCodeRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomByCodeRequestMessage.ProtoReflect.Descriptor instead.
+func (*JoinRoomByCodeRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *JoinRoomByCodeRequestMessage) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+type KickPlayerRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickPlayerRequestMessage) Reset() {
+	*x = KickPlayerRequestMessage{}
+	mi := &file_packets_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickPlayerRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerRequestMessage) ProtoMessage() {}
+
+func (x *KickPlayerRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerRequestMessage.ProtoReflect.Descriptor instead.
+func (*KickPlayerRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *KickPlayerRequestMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+type LockRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locked        bool                   `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockRoomRequestMessage) Reset() {
+	*x = LockRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockRoomRequestMessage) ProtoMessage() {}
+
+func (x *LockRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*LockRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LockRoomRequestMessage) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+type CloseRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseRoomRequestMessage) Reset() {
+	*x = CloseRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRoomRequestMessage) ProtoMessage() {}
+
+func (x *CloseRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*CloseRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{41}
+}
+
+type RemovedFromRoomMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovedFromRoomMessage) Reset() {
+	*x = RemovedFromRoomMessage{}
+	mi := &file_packets_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovedFromRoomMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovedFromRoomMessage) ProtoMessage() {}
+
+func (x *RemovedFromRoomMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovedFromRoomMessage.ProtoReflect.Descriptor instead.
+func (*RemovedFromRoomMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RemovedFromRoomMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GameOverMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WinnerId      uint64                 `protobuf:"varint,1,opt,name=winner_id,json=winnerId,proto3" json:"winner_id,omitempty"`
+	WinnerName    string                 `protobuf:"bytes,2,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameOverMessage) Reset() {
+	*x = GameOverMessage{}
+	mi := &file_packets_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameOverMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameOverMessage) ProtoMessage() {}
+
+func (x *GameOverMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameOverMessage.ProtoReflect.Descriptor instead.
+func (*GameOverMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GameOverMessage) GetWinnerId() uint64 {
+	if x != nil {
+		return x.WinnerId
+	}
+	return 0
+}
+
+func (x *GameOverMessage) GetWinnerName() string {
+	if x != nil {
+		return x.WinnerName
+	}
+	return ""
+}
+
+// Team 0 means the player isn't on a team
+type TeamAssignmentMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Team          uint32                 `protobuf:"varint,2,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamAssignmentMessage) Reset() {
+	*x = TeamAssignmentMessage{}
+	mi := &file_packets_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamAssignmentMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamAssignmentMessage) ProtoMessage() {}
+
+func (x *TeamAssignmentMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamAssignmentMessage.ProtoReflect.Descriptor instead.
+func (*TeamAssignmentMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TeamAssignmentMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *TeamAssignmentMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+type TeamScoreMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          uint32                 `protobuf:"varint,1,opt,name=team,proto3" json:"team,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         uint32                 `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	Mass          uint64                 `protobuf:"varint,4,opt,name=mass,proto3" json:"mass,omitempty"`
+	Players       uint32                 `protobuf:"varint,5,opt,name=players,proto3" json:"players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoreMessage) Reset() {
+	*x = TeamScoreMessage{}
+	mi := &file_packets_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoreMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoreMessage) ProtoMessage() {}
+
+func (x *TeamScoreMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoreMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TeamScoreMessage) GetTeam() uint32 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TeamScoreMessage) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetMass() uint64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+func (x *TeamScoreMessage) GetPlayers() uint32 {
+	if x != nil {
+		return x.Players
+	}
+	return 0
+}
+
+type TeamScoreboardMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamScoreMessage    `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamScoreboardMessage) Reset() {
+	*x = TeamScoreboardMessage{}
+	mi := &file_packets_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamScoreboardMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamScoreboardMessage) ProtoMessage() {}
+
+func (x *TeamScoreboardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamScoreboardMessage.ProtoReflect.Descriptor instead.
+func (*TeamScoreboardMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TeamScoreboardMessage) GetTeams() []*TeamScoreMessage {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type RoundCountdownMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SecondsLeft   uint32                 `protobuf:"varint,1,opt,name=seconds_left,json=secondsLeft,proto3" json:"seconds_left,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundCountdownMessage) Reset() {
+	*x = RoundCountdownMessage{}
+	mi := &file_packets_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundCountdownMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundCountdownMessage) ProtoMessage() {}
+
+func (x *RoundCountdownMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundCountdownMessage.ProtoReflect.Descriptor instead.
+func (*RoundCountdownMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RoundCountdownMessage) GetSecondsLeft() uint32 {
+	if x != nil {
+		return x.SecondsLeft
+	}
+	return 0
+}
+
+type RoundResultMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mass          uint64                 `protobuf:"varint,3,opt,name=mass,proto3" json:"mass,omitempty"`
+	Rank          uint32                 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundResultMessage) Reset() {
+	*x = RoundResultMessage{}
+	mi := &file_packets_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundResultMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundResultMessage) ProtoMessage() {}
+
+func (x *RoundResultMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundResultMessage.ProtoReflect.Descriptor instead.
+func (*RoundResultMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RoundResultMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RoundResultMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoundResultMessage) GetMass() uint64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+func (x *RoundResultMessage) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// The final standings of a round, best first
+type RoundEndMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*RoundResultMessage  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoundEndMessage) Reset() {
+	*x = RoundEndMessage{}
+	mi := &file_packets_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoundEndMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoundEndMessage) ProtoMessage() {}
+
+func (x *RoundEndMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoundEndMessage.ProtoReflect.Descriptor instead.
+func (*RoundEndMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RoundEndMessage) GetResults() []*RoundResultMessage {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// The rectangle players can move in, sent when entering a room
+type WorldBoundsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinX          float64                `protobuf:"fixed64,1,opt,name=min_x,json=minX,proto3" json:"min_x,omitempty"`
+	MinY          float64                `protobuf:"fixed64,2,opt,name=min_y,json=minY,proto3" json:"min_y,omitempty"`
+	MaxX          float64                `protobuf:"fixed64,3,opt,name=max_x,json=maxX,proto3" json:"max_x,omitempty"`
+	MaxY          float64                `protobuf:"fixed64,4,opt,name=max_y,json=maxY,proto3" json:"max_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorldBoundsMessage) Reset() {
+	*x = WorldBoundsMessage{}
+	mi := &file_packets_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorldBoundsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorldBoundsMessage) ProtoMessage() {}
+
+func (x *WorldBoundsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorldBoundsMessage.ProtoReflect.Descriptor instead.
+func (*WorldBoundsMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WorldBoundsMessage) GetMinX() float64 {
+	if x != nil {
+		return x.MinX
+	}
+	return 0
+}
+
+func (x *WorldBoundsMessage) GetMinY() float64 {
+	if x != nil {
+		return x.MinY
+	}
+	return 0
+}
+
+func (x *WorldBoundsMessage) GetMaxX() float64 {
+	if x != nil {
+		return x.MaxX
+	}
+	return 0
+}
+
+func (x *WorldBoundsMessage) GetMaxY() float64 {
+	if x != nil {
+		return x.MaxY
+	}
+	return 0
+}
+
+// Players outside the safe zone lose mass, it shrinks towards target_radius while a battle royale game is running
+type ZoneMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
+	Radius        float64                `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	TargetRadius  float64                `protobuf:"fixed64,4,opt,name=target_radius,json=targetRadius,proto3" json:"target_radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneMessage) Reset() {
+	*x = ZoneMessage{}
+	mi := &file_packets_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneMessage) ProtoMessage() {}
+
+func (x *ZoneMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneMessage.ProtoReflect.Descriptor instead.
+func (*ZoneMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ZoneMessage) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *ZoneMessage) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *ZoneMessage) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *ZoneMessage) GetTargetRadius() float64 {
+	if x != nil {
+		return x.TargetRadius
+	}
+	return 0
+}
+
+type GameMapMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Obstacles     []*WorldBoundsMessage  `protobuf:"bytes,2,rep,name=obstacles,proto3" json:"obstacles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameMapMessage) Reset() {
+	*x = GameMapMessage{}
+	mi := &file_packets_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameMapMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameMapMessage) ProtoMessage() {}
+
+func (x *GameMapMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameMapMessage.ProtoReflect.Descriptor instead.
+func (*GameMapMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GameMapMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameMapMessage) GetObstacles() []*WorldBoundsMessage {
+	if x != nil {
+		return x.Obstacles
+	}
+	return nil
+}
+
+// How the life that just ended went, killer_id is 0 if nobody ate the player
+type DeathSummaryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KillerId      uint64                 `protobuf:"varint,1,opt,name=killer_id,json=killerId,proto3" json:"killer_id,omitempty"`
+	KillerName    string                 `protobuf:"bytes,2,opt,name=killer_name,json=killerName,proto3" json:"killer_name,omitempty"`
+	TimeAliveMs   uint64                 `protobuf:"varint,3,opt,name=time_alive_ms,json=timeAliveMs,proto3" json:"time_alive_ms,omitempty"`
+	PeakMass      float64                `protobuf:"fixed64,4,opt,name=peak_mass,json=peakMass,proto3" json:"peak_mass,omitempty"`
+	Kills         uint32                 `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+	SporesEaten   uint32                 `protobuf:"varint,6,opt,name=spores_eaten,json=sporesEaten,proto3" json:"spores_eaten,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeathSummaryMessage) Reset() {
+	*x = DeathSummaryMessage{}
+	mi := &file_packets_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeathSummaryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeathSummaryMessage) ProtoMessage() {}
+
+func (x *DeathSummaryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeathSummaryMessage.ProtoReflect.Descriptor instead.
+func (*DeathSummaryMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeathSummaryMessage) GetKillerId() uint64 {
+	if x != nil {
+		return x.KillerId
+	}
+	return 0
+}
+
+func (x *DeathSummaryMessage) GetKillerName() string {
+	if x != nil {
+		return x.KillerName
+	}
+	return ""
+}
+
+func (x *DeathSummaryMessage) GetTimeAliveMs() uint64 {
+	if x != nil {
+		return x.TimeAliveMs
+	}
+	return 0
+}
+
+func (x *DeathSummaryMessage) GetPeakMass() float64 {
+	if x != nil {
+		return x.PeakMass
+	}
+	return 0
+}
+
+func (x *DeathSummaryMessage) GetKills() uint32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *DeathSummaryMessage) GetSporesEaten() uint32 {
+	if x != nil {
+		return x.SporesEaten
+	}
+	return 0
+}
+
+type RespawnRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespawnRequestMessage) Reset() {
+	*x = RespawnRequestMessage{}
+	mi := &file_packets_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespawnRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespawnRequestMessage) ProtoMessage() {}
+
+func (x *RespawnRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespawnRequestMessage.ProtoReflect.Descriptor instead.
+func (*RespawnRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{54}
+}
+
+type SpectateRoomRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoomId        uint64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateRoomRequestMessage) Reset() {
+	*x = SpectateRoomRequestMessage{}
+	mi := &file_packets_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateRoomRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRoomRequestMessage) ProtoMessage() {}
+
+func (x *SpectateRoomRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRoomRequestMessage.ProtoReflect.Descriptor instead.
+func (*SpectateRoomRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SpectateRoomRequestMessage) GetRoomId() uint64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+// Watch the player with the given ID, or whoever is in the lead if it's 0
+type FollowPlayerRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowPlayerRequestMessage) Reset() {
+	*x = FollowPlayerRequestMessage{}
+	mi := &file_packets_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowPlayerRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowPlayerRequestMessage) ProtoMessage() {}
+
+func (x *FollowPlayerRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowPlayerRequestMessage.ProtoReflect.Descriptor instead.
+func (*FollowPlayerRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FollowPlayerRequestMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+// Switch to watching the next player, or the previous one
+type CycleSpectateTargetRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Previous      bool                   `protobuf:"varint,1,opt,name=previous,proto3" json:"previous,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleSpectateTargetRequestMessage) Reset() {
+	*x = CycleSpectateTargetRequestMessage{}
+	mi := &file_packets_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleSpectateTargetRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleSpectateTargetRequestMessage) ProtoMessage() {}
+
+func (x *CycleSpectateTargetRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleSpectateTargetRequestMessage.ProtoReflect.Descriptor instead.
+func (*CycleSpectateTargetRequestMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CycleSpectateTargetRequestMessage) GetPrevious() bool {
+	if x != nil {
+		return x.Previous
+	}
+	return false
+}
+
+// Who a spectator is watching now, leader is set if they follow whoever is in the lead
+type SpectateTargetMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      uint64                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Leader        bool                   `protobuf:"varint,3,opt,name=leader,proto3" json:"leader,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateTargetMessage) Reset() {
+	*x = SpectateTargetMessage{}
+	mi := &file_packets_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateTargetMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateTargetMessage) ProtoMessage() {}
+
+func (x *SpectateTargetMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateTargetMessage.ProtoReflect.Descriptor instead.
+func (*SpectateTargetMessage) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SpectateTargetMessage) GetPlayerId() uint64 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *SpectateTargetMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpectateTargetMessage) GetLeader() bool {
+	if x != nil {
+		return x.Leader
+	}
+	return false
+}
+
+type Packet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SenderId uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	// Types that are valid to be assigned to Msg:
+	//
+	//	*Packet_Chat
+	//	*Packet_Id
+	//	*Packet_LoginRequest
+	//	*Packet_RegisterRequest
+	//	*Packet_OkResponse
+	//	*Packet_DenyResponse
+	//	*Packet_Player
+	//	*Packet_PlayerDirection
+	//	*Packet_Spore
+	//	*Packet_SporeConsumed
+	//	*Packet_SporeBatch
+	//	*Packet_PlayerConsumed
+	//	*Packet_HiscoreBoardRequest
+	//	*Packet_Hiscore
+	//	*Packet_HiscoreBoard
+	//	*Packet_FinishBrowsingHiscores
+	//	*Packet_SearchHiscore
+	//	*Packet_Disconnect
+	//	*Packet_ViewEnter
+	//	*Packet_ViewLeave
+	//	*Packet_Snapshot
+	//	*Packet_SnapshotAck
+	//	*Packet_RoomListRequest
+	//	*Packet_RoomList
+	//	*Packet_JoinRoomRequest
+	//	*Packet_CreateRoomRequest
+	//	*Packet_RoomCreated
+	//	*Packet_JoinRoomByCodeRequest
+	//	*Packet_KickPlayerRequest
+	//	*Packet_LockRoomRequest
+	//	*Packet_CloseRoomRequest
+	//	*Packet_RemovedFromRoom
+	//	*Packet_GameOver
+	//	*Packet_TeamAssignment
+	//	*Packet_TeamScoreboard
+	//	*Packet_RoundCountdown
+	//	*Packet_RoundEnd
+	//	*Packet_Zone
+	//	*Packet_WorldBounds
+	//	*Packet_PlayerSplit
+	//	*Packet_PlayerEject
+	//	*Packet_PlayerTarget
+	//	*Packet_HazardBurst
+	//	*Packet_PowerUpCollected
+	//	*Packet_PowerUpExpired
+	//	*Packet_GameMap
+	//	*Packet_DeathSummary
+	//	*Packet_RespawnRequest
+	//	*Packet_SpectateRoomRequest
+	//	*Packet_FollowPlayerRequest
+	//	*Packet_CycleSpectateTargetRequest
+	//	*Packet_SpectateTarget
+	Msg           isPacket_Msg `protobuf_oneof:"msg"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Packet) Reset() {
+	*x = Packet{}
+	mi := &file_packets_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Packet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Packet) ProtoMessage() {}
+
+func (x *Packet) ProtoReflect() protoreflect.Message {
+	mi := &file_packets_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
+func (*Packet) Descriptor() ([]byte, []int) {
+	return file_packets_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Packet) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *Packet) GetMsg() isPacket_Msg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *Packet) GetChat() *ChatMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Chat); ok {
+			return x.Chat
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetId() *IdMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Id); ok {
+			return x.Id
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
+			return x.LoginRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
+			return x.RegisterRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetOkResponse() *OkResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_OkResponse); ok {
+			return x.OkResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
+			return x.DenyResponse
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayer() *PlayerMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Player); ok {
+			return x.Player
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
+			return x.PlayerDirection
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSpore() *SporeMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Spore); ok {
+			return x.Spore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
+			return x.SporeConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSporeBatch() *SporeBatchMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SporeBatch); ok {
+			return x.SporeBatch
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
+			return x.PlayerConsumed
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscoreBoardRequest() *HiscoreBoardRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiscoreBoardRequest); ok {
+			return x.HiscoreBoardRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscore() *HiscoreMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Hiscore); ok {
+			return x.Hiscore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
+			return x.HiscoreBoard
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFinishBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FinishBrowsingHiscores); ok {
+			return x.FinishBrowsingHiscores
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
+			return x.SearchHiscore
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDisconnect() *DisconnectMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_Disconnect); ok {
+			return x.Disconnect
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetViewEnter() *ViewEnterMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ViewEnter); ok {
+			return x.ViewEnter
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetViewLeave() *ViewLeaveMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_ViewLeave); ok {
+			return x.ViewLeave
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSnapshot() *SnapshotMessage {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Msg.(*Packet_Snapshot); ok {
+			return x.Snapshot
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Packet.ProtoReflect.Descriptor instead.
-func (*Packet) Descriptor() ([]byte, []int) {
-	return file_packets_proto_rawDescGZIP(), []int{18}
+func (x *Packet) GetSnapshotAck() *SnapshotAckMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SnapshotAck); ok {
+			return x.SnapshotAck
+		}
+	}
+	return nil
 }
 
-func (x *Packet) GetSenderId() uint64 {
+func (x *Packet) GetRoomListRequest() *RoomListRequestMessage {
 	if x != nil {
-		return x.SenderId
+		if x, ok := x.Msg.(*Packet_RoomListRequest); ok {
+			return x.RoomListRequest
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *Packet) GetMsg() isPacket_Msg {
+func (x *Packet) GetRoomList() *RoomListMessage {
 	if x != nil {
-		return x.Msg
+		if x, ok := x.Msg.(*Packet_RoomList); ok {
+			return x.RoomList
+		}
 	}
 	return nil
 }
 
-func (x *Packet) GetChat() *ChatMessage {
+func (x *Packet) GetJoinRoomRequest() *JoinRoomRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Chat); ok {
-			return x.Chat
+		if x, ok := x.Msg.(*Packet_JoinRoomRequest); ok {
+			return x.JoinRoomRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetId() *IdMessage {
+func (x *Packet) GetCreateRoomRequest() *CreateRoomRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Id); ok {
-			return x.Id
+		if x, ok := x.Msg.(*Packet_CreateRoomRequest); ok {
+			return x.CreateRoomRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetLoginRequest() *LoginRequestMessage {
+func (x *Packet) GetRoomCreated() *RoomCreatedMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_LoginRequest); ok {
-			return x.LoginRequest
+		if x, ok := x.Msg.(*Packet_RoomCreated); ok {
+			return x.RoomCreated
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetRegisterRequest() *RegisterRequestMessage {
+func (x *Packet) GetJoinRoomByCodeRequest() *JoinRoomByCodeRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_RegisterRequest); ok {
-			return x.RegisterRequest
+		if x, ok := x.Msg.(*Packet_JoinRoomByCodeRequest); ok {
+			return x.JoinRoomByCodeRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetOkResponse() *OkResponseMessage {
+func (x *Packet) GetKickPlayerRequest() *KickPlayerRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_OkResponse); ok {
-			return x.OkResponse
+		if x, ok := x.Msg.(*Packet_KickPlayerRequest); ok {
+			return x.KickPlayerRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDenyResponse() *DenyResponseMessage {
+func (x *Packet) GetLockRoomRequest() *LockRoomRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_DenyResponse); ok {
-			return x.DenyResponse
+		if x, ok := x.Msg.(*Packet_LockRoomRequest); ok {
+			return x.LockRoomRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayer() *PlayerMessage {
+func (x *Packet) GetCloseRoomRequest() *CloseRoomRequestMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Player); ok {
-			return x.Player
+		if x, ok := x.Msg.(*Packet_CloseRoomRequest); ok {
+			return x.CloseRoomRequest
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerDirection() *PlayerDirectionMessage {
+func (x *Packet) GetRemovedFromRoom() *RemovedFromRoomMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerDirection); ok {
-			return x.PlayerDirection
+		if x, ok := x.Msg.(*Packet_RemovedFromRoom); ok {
+			return x.RemovedFromRoom
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSpore() *SporeMessage {
+func (x *Packet) GetGameOver() *GameOverMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Spore); ok {
-			return x.Spore
+		if x, ok := x.Msg.(*Packet_GameOver); ok {
+			return x.GameOver
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporeConsumed() *SporeConsumedMessage {
+func (x *Packet) GetTeamAssignment() *TeamAssignmentMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporeConsumed); ok {
-			return x.SporeConsumed
+		if x, ok := x.Msg.(*Packet_TeamAssignment); ok {
+			return x.TeamAssignment
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSporeBatch() *SporeBatchMessage {
+func (x *Packet) GetTeamScoreboard() *TeamScoreboardMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SporeBatch); ok {
-			return x.SporeBatch
+		if x, ok := x.Msg.(*Packet_TeamScoreboard); ok {
+			return x.TeamScoreboard
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetPlayerConsumed() *PlayerConsumedMessage {
+func (x *Packet) GetRoundCountdown() *RoundCountdownMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_PlayerConsumed); ok {
-			return x.PlayerConsumed
+		if x, ok := x.Msg.(*Packet_RoundCountdown); ok {
+			return x.RoundCountdown
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscoreBoardRequest() *HiscoreBoardRequestMessage {
+func (x *Packet) GetRoundEnd() *RoundEndMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiscoreBoardRequest); ok {
-			return x.HiscoreBoardRequest
+		if x, ok := x.Msg.(*Packet_RoundEnd); ok {
+			return x.RoundEnd
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscore() *HiscoreMessage {
+func (x *Packet) GetZone() *ZoneMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Hiscore); ok {
-			return x.Hiscore
+		if x, ok := x.Msg.(*Packet_Zone); ok {
+			return x.Zone
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetHiscoreBoard() *HiscoreBoardMessage {
+func (x *Packet) GetWorldBounds() *WorldBoundsMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_HiscoreBoard); ok {
-			return x.HiscoreBoard
+		if x, ok := x.Msg.(*Packet_WorldBounds); ok {
+			return x.WorldBounds
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetFinishBrowsingHiscores() *FinishedBrowsingHiscoresMessage {
+func (x *Packet) GetPlayerSplit() *PlayerSplitMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_FinishBrowsingHiscores); ok {
-			return x.FinishBrowsingHiscores
+		if x, ok := x.Msg.(*Packet_PlayerSplit); ok {
+			return x.PlayerSplit
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetSearchHiscore() *SearchHiscoreMessage {
+func (x *Packet) GetPlayerEject() *PlayerEjectMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_SearchHiscore); ok {
-			return x.SearchHiscore
+		if x, ok := x.Msg.(*Packet_PlayerEject); ok {
+			return x.PlayerEject
 		}
 	}
 	return nil
 }
 
-func (x *Packet) GetDisconnect() *DisconnectMessage {
+func (x *Packet) GetPlayerTarget() *PlayerTargetMessage {
 	if x != nil {
-		if x, ok := x.Msg.(*Packet_Disconnect); ok {
-			return x.Disconnect
+		if x, ok := x.Msg.(*Packet_PlayerTarget); ok {
+			return x.PlayerTarget
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetHazardBurst() *HazardBurstMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_HazardBurst); ok {
+			return x.HazardBurst
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPowerUpCollected() *PowerUpCollectedMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PowerUpCollected); ok {
+			return x.PowerUpCollected
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetPowerUpExpired() *PowerUpExpiredMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_PowerUpExpired); ok {
+			return x.PowerUpExpired
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetGameMap() *GameMapMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_GameMap); ok {
+			return x.GameMap
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetDeathSummary() *DeathSummaryMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_DeathSummary); ok {
+			return x.DeathSummary
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetRespawnRequest() *RespawnRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_RespawnRequest); ok {
+			return x.RespawnRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSpectateRoomRequest() *SpectateRoomRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SpectateRoomRequest); ok {
+			return x.SpectateRoomRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetFollowPlayerRequest() *FollowPlayerRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_FollowPlayerRequest); ok {
+			return x.FollowPlayerRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetCycleSpectateTargetRequest() *CycleSpectateTargetRequestMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_CycleSpectateTargetRequest); ok {
+			return x.CycleSpectateTargetRequest
+		}
+	}
+	return nil
+}
+
+func (x *Packet) GetSpectateTarget() *SpectateTargetMessage {
+	if x != nil {
+		if x, ok := x.Msg.(*Packet_SpectateTarget); ok {
+			return x.SpectateTarget
 		}
 	}
 	return nil
@@ -1219,6 +3998,142 @@ type Packet_Disconnect struct {
 	Disconnect *DisconnectMessage `protobuf:"bytes,19,opt,name=disconnect,proto3,oneof"`
 }
 
+type Packet_ViewEnter struct {
+	ViewEnter *ViewEnterMessage `protobuf:"bytes,20,opt,name=view_enter,json=viewEnter,proto3,oneof"`
+}
+
+type Packet_ViewLeave struct {
+	ViewLeave *ViewLeaveMessage `protobuf:"bytes,21,opt,name=view_leave,json=viewLeave,proto3,oneof"`
+}
+
+type Packet_Snapshot struct {
+	Snapshot *SnapshotMessage `protobuf:"bytes,22,opt,name=snapshot,proto3,oneof"`
+}
+
+type Packet_SnapshotAck struct {
+	SnapshotAck *SnapshotAckMessage `protobuf:"bytes,23,opt,name=snapshot_ack,json=snapshotAck,proto3,oneof"`
+}
+
+type Packet_RoomListRequest struct {
+	RoomListRequest *RoomListRequestMessage `protobuf:"bytes,24,opt,name=room_list_request,json=roomListRequest,proto3,oneof"`
+}
+
+type Packet_RoomList struct {
+	RoomList *RoomListMessage `protobuf:"bytes,25,opt,name=room_list,json=roomList,proto3,oneof"`
+}
+
+type Packet_JoinRoomRequest struct {
+	JoinRoomRequest *JoinRoomRequestMessage `protobuf:"bytes,26,opt,name=join_room_request,json=joinRoomRequest,proto3,oneof"`
+}
+
+type Packet_CreateRoomRequest struct {
+	CreateRoomRequest *CreateRoomRequestMessage `protobuf:"bytes,27,opt,name=create_room_request,json=createRoomRequest,proto3,oneof"`
+}
+
+type Packet_RoomCreated struct {
+	RoomCreated *RoomCreatedMessage `protobuf:"bytes,28,opt,name=room_created,json=roomCreated,proto3,oneof"`
+}
+
+type Packet_JoinRoomByCodeRequest struct {
+	JoinRoomByCodeRequest *JoinRoomByCodeRequestMessage `protobuf:"bytes,29,opt,name=join_room_by_code_request,json=joinRoomByCodeRequest,proto3,oneof"`
+}
+
+type Packet_KickPlayerRequest struct {
+	KickPlayerRequest *KickPlayerRequestMessage `protobuf:"bytes,30,opt,name=kick_player_request,json=kickPlayerRequest,proto3,oneof"`
+}
+
+type Packet_LockRoomRequest struct {
+	LockRoomRequest *LockRoomRequestMessage `protobuf:"bytes,31,opt,name=lock_room_request,json=lockRoomRequest,proto3,oneof"`
+}
+
+type Packet_CloseRoomRequest struct {
+	CloseRoomRequest *CloseRoomRequestMessage `protobuf:"bytes,32,opt,name=close_room_request,json=closeRoomRequest,proto3,oneof"`
+}
+
+type Packet_RemovedFromRoom struct {
+	RemovedFromRoom *RemovedFromRoomMessage `protobuf:"bytes,33,opt,name=removed_from_room,json=removedFromRoom,proto3,oneof"`
+}
+
+type Packet_GameOver struct {
+	GameOver *GameOverMessage `protobuf:"bytes,34,opt,name=game_over,json=gameOver,proto3,oneof"`
+}
+
+type Packet_TeamAssignment struct {
+	TeamAssignment *TeamAssignmentMessage `protobuf:"bytes,35,opt,name=team_assignment,json=teamAssignment,proto3,oneof"`
+}
+
+type Packet_TeamScoreboard struct {
+	TeamScoreboard *TeamScoreboardMessage `protobuf:"bytes,36,opt,name=team_scoreboard,json=teamScoreboard,proto3,oneof"`
+}
+
+type Packet_RoundCountdown struct {
+	RoundCountdown *RoundCountdownMessage `protobuf:"bytes,37,opt,name=round_countdown,json=roundCountdown,proto3,oneof"`
+}
+
+type Packet_RoundEnd struct {
+	RoundEnd *RoundEndMessage `protobuf:"bytes,38,opt,name=round_end,json=roundEnd,proto3,oneof"`
+}
+
+type Packet_Zone struct {
+	Zone *ZoneMessage `protobuf:"bytes,39,opt,name=zone,proto3,oneof"`
+}
+
+type Packet_WorldBounds struct {
+	WorldBounds *WorldBoundsMessage `protobuf:"bytes,40,opt,name=world_bounds,json=worldBounds,proto3,oneof"`
+}
+
+type Packet_PlayerSplit struct {
+	PlayerSplit *PlayerSplitMessage `protobuf:"bytes,41,opt,name=player_split,json=playerSplit,proto3,oneof"`
+}
+
+type Packet_PlayerEject struct {
+	PlayerEject *PlayerEjectMessage `protobuf:"bytes,42,opt,name=player_eject,json=playerEject,proto3,oneof"`
+}
+
+type Packet_PlayerTarget struct {
+	PlayerTarget *PlayerTargetMessage `protobuf:"bytes,43,opt,name=player_target,json=playerTarget,proto3,oneof"`
+}
+
+type Packet_HazardBurst struct {
+	HazardBurst *HazardBurstMessage `protobuf:"bytes,44,opt,name=hazard_burst,json=hazardBurst,proto3,oneof"`
+}
+
+type Packet_PowerUpCollected struct {
+	PowerUpCollected *PowerUpCollectedMessage `protobuf:"bytes,45,opt,name=power_up_collected,json=powerUpCollected,proto3,oneof"`
+}
+
+type Packet_PowerUpExpired struct {
+	PowerUpExpired *PowerUpExpiredMessage `protobuf:"bytes,46,opt,name=power_up_expired,json=powerUpExpired,proto3,oneof"`
+}
+
+type Packet_GameMap struct {
+	GameMap *GameMapMessage `protobuf:"bytes,47,opt,name=game_map,json=gameMap,proto3,oneof"`
+}
+
+type Packet_DeathSummary struct {
+	DeathSummary *DeathSummaryMessage `protobuf:"bytes,48,opt,name=death_summary,json=deathSummary,proto3,oneof"`
+}
+
+type Packet_RespawnRequest struct {
+	RespawnRequest *RespawnRequestMessage `protobuf:"bytes,49,opt,name=respawn_request,json=respawnRequest,proto3,oneof"`
+}
+
+type Packet_SpectateRoomRequest struct {
+	SpectateRoomRequest *SpectateRoomRequestMessage `protobuf:"bytes,50,opt,name=spectate_room_request,json=spectateRoomRequest,proto3,oneof"`
+}
+
+type Packet_FollowPlayerRequest struct {
+	FollowPlayerRequest *FollowPlayerRequestMessage `protobuf:"bytes,51,opt,name=follow_player_request,json=followPlayerRequest,proto3,oneof"`
+}
+
+type Packet_CycleSpectateTargetRequest struct {
+	CycleSpectateTargetRequest *CycleSpectateTargetRequestMessage `protobuf:"bytes,52,opt,name=cycle_spectate_target_request,json=cycleSpectateTargetRequest,proto3,oneof"`
+}
+
+type Packet_SpectateTarget struct {
+	SpectateTarget *SpectateTargetMessage `protobuf:"bytes,53,opt,name=spectate_target,json=spectateTarget,proto3,oneof"`
+}
+
 func (*Packet_Chat) isPacket_Msg() {}
 
 func (*Packet_Id) isPacket_Msg() {}
@@ -1255,6 +4170,74 @@ func (*Packet_SearchHiscore) isPacket_Msg() {}
 
 func (*Packet_Disconnect) isPacket_Msg() {}
 
+func (*Packet_ViewEnter) isPacket_Msg() {}
+
+func (*Packet_ViewLeave) isPacket_Msg() {}
+
+func (*Packet_Snapshot) isPacket_Msg() {}
+
+func (*Packet_SnapshotAck) isPacket_Msg() {}
+
+func (*Packet_RoomListRequest) isPacket_Msg() {}
+
+func (*Packet_RoomList) isPacket_Msg() {}
+
+func (*Packet_JoinRoomRequest) isPacket_Msg() {}
+
+func (*Packet_CreateRoomRequest) isPacket_Msg() {}
+
+func (*Packet_RoomCreated) isPacket_Msg() {}
+
+func (*Packet_JoinRoomByCodeRequest) isPacket_Msg() {}
+
+func (*Packet_KickPlayerRequest) isPacket_Msg() {}
+
+func (*Packet_LockRoomRequest) isPacket_Msg() {}
+
+func (*Packet_CloseRoomRequest) isPacket_Msg() {}
+
+func (*Packet_RemovedFromRoom) isPacket_Msg() {}
+
+func (*Packet_GameOver) isPacket_Msg() {}
+
+func (*Packet_TeamAssignment) isPacket_Msg() {}
+
+func (*Packet_TeamScoreboard) isPacket_Msg() {}
+
+func (*Packet_RoundCountdown) isPacket_Msg() {}
+
+func (*Packet_RoundEnd) isPacket_Msg() {}
+
+func (*Packet_Zone) isPacket_Msg() {}
+
+func (*Packet_WorldBounds) isPacket_Msg() {}
+
+func (*Packet_PlayerSplit) isPacket_Msg() {}
+
+func (*Packet_PlayerEject) isPacket_Msg() {}
+
+func (*Packet_PlayerTarget) isPacket_Msg() {}
+
+func (*Packet_HazardBurst) isPacket_Msg() {}
+
+func (*Packet_PowerUpCollected) isPacket_Msg() {}
+
+func (*Packet_PowerUpExpired) isPacket_Msg() {}
+
+func (*Packet_GameMap) isPacket_Msg() {}
+
+func (*Packet_DeathSummary) isPacket_Msg() {}
+
+func (*Packet_RespawnRequest) isPacket_Msg() {}
+
+func (*Packet_SpectateRoomRequest) isPacket_Msg() {}
+
+func (*Packet_FollowPlayerRequest) isPacket_Msg() {}
+
+func (*Packet_CycleSpectateTargetRequest) isPacket_Msg() {}
+
+func (*Packet_SpectateTarget) isPacket_Msg() {}
+
 var File_packets_proto protoreflect.FileDescriptor
 
 const file_packets_proto_rawDesc = "" +
@@ -1273,7 +4256,7 @@ const file_packets_proto_rawDesc = "" +
 	"\x05color\x18\x03 \x01(\rR\x05color\"\x13\n" +
 	"\x11OkResponseMessage\"'\n" +
 	"\x13DenyResponseMessage\x12\x10\n" +
-	"\x03msg\x18\x01 \x01(\tR\x03msg\"\xb1\x01\n" +
+	"\x03msg\x18\x01 \x01(\tR\x03msg\"\xac\x02\n" +
 	"\rPlayerMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\f\n" +
@@ -1282,14 +4265,27 @@ const file_packets_proto_rawDesc = "" +
 	"\x06radius\x18\x05 \x01(\x01R\x06radius\x12\x1c\n" +
 	"\tdirection\x18\x06 \x01(\x01R\tdirection\x12\x14\n" +
 	"\x05speed\x18\a \x01(\x01R\x05speed\x12\x14\n" +
-	"\x05color\x18\b \x01(\rR\x05color\"6\n" +
+	"\x05color\x18\b \x01(\rR\x05color\x12\x12\n" +
+	"\x04team\x18\t \x01(\rR\x04team\x12\x1d\n" +
+	"\n" +
+	"velocity_x\x18\n" +
+	" \x01(\x01R\tvelocityX\x12\x1d\n" +
+	"\n" +
+	"velocity_y\x18\v \x01(\x01R\tvelocityY\x12'\n" +
+	"\x0fspawn_protected\x18\f \x01(\bR\x0espawnProtected\"6\n" +
 	"\x16PlayerDirectionMessage\x12\x1c\n" +
-	"\tdirection\x18\x02 \x01(\x01R\tdirection\"R\n" +
+	"\tdirection\x18\x02 \x01(\x01R\tdirection\"1\n" +
+	"\x13PlayerTargetMessage\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\"\x14\n" +
+	"\x12PlayerSplitMessage\"\x14\n" +
+	"\x12PlayerEjectMessage\"z\n" +
 	"\fSporeMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
 	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x16\n" +
-	"\x06radius\x18\x04 \x01(\x01R\x06radius\"1\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\x12&\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x12.packets.SporeKindR\x04kind\"1\n" +
 	"\x14SporeConsumedMessage\x12\x19\n" +
 	"\bspore_id\x18\x01 \x01(\x04R\asporeId\"B\n" +
 	"\x11SporeBatchMessage\x12-\n" +
@@ -1307,7 +4303,166 @@ const file_packets_proto_rawDesc = "" +
 	"\x14SearchHiscoreMessage\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"+\n" +
 	"\x11DisconnectMessage\x12\x16\n" +
-	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xd7\t\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xaf\x01\n" +
+	"\x10ViewEnterMessage\x12-\n" +
+	"\x06spores\x18\x02 \x03(\v2\x15.packets.SporeMessageR\x06spores\x120\n" +
+	"\ahazards\x18\x03 \x03(\v2\x16.packets.HazardMessageR\ahazards\x124\n" +
+	"\tpower_ups\x18\x04 \x03(\v2\x17.packets.PowerUpMessageR\bpowerUpsJ\x04\b\x01\x10\x02\"v\n" +
+	"\x10ViewLeaveMessage\x12\x1b\n" +
+	"\tspore_ids\x18\x02 \x03(\x04R\bsporeIds\x12\x1d\n" +
+	"\n" +
+	"hazard_ids\x18\x03 \x03(\x04R\thazardIds\x12 \n" +
+	"\fpower_up_ids\x18\x04 \x03(\x04R\n" +
+	"powerUpIdsJ\x04\b\x01\x10\x02\"S\n" +
+	"\rHazardMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\"N\n" +
+	"\x12HazardBurstMessage\x12\x1b\n" +
+	"\thazard_id\x18\x01 \x01(\x04R\bhazardId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x04R\bplayerId\"~\n" +
+	"\x0ePowerUpMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\f\n" +
+	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x03 \x01(\x01R\x01y\x12\x16\n" +
+	"\x06radius\x18\x04 \x01(\x01R\x06radius\x12(\n" +
+	"\x04kind\x18\x05 \x01(\x0e2\x14.packets.PowerUpKindR\x04kind\"\xa1\x01\n" +
+	"\x17PowerUpCollectedMessage\x12\x1e\n" +
+	"\vpower_up_id\x18\x01 \x01(\x04R\tpowerUpId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x04R\bplayerId\x12(\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x14.packets.PowerUpKindR\x04kind\x12\x1f\n" +
+	"\vduration_ms\x18\x04 \x01(\rR\n" +
+	"durationMs\"^\n" +
+	"\x15PowerUpExpiredMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x12(\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x14.packets.PowerUpKindR\x04kind\"\xe5\x03\n" +
+	"\x12PlayerDeltaMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x11\n" +
+	"\x01x\x18\x03 \x01(\x01H\x01R\x01x\x88\x01\x01\x12\x11\n" +
+	"\x01y\x18\x04 \x01(\x01H\x02R\x01y\x88\x01\x01\x12\x1b\n" +
+	"\x06radius\x18\x05 \x01(\x01H\x03R\x06radius\x88\x01\x01\x12!\n" +
+	"\tdirection\x18\x06 \x01(\x01H\x04R\tdirection\x88\x01\x01\x12\x19\n" +
+	"\x05speed\x18\a \x01(\x01H\x05R\x05speed\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\b \x01(\rH\x06R\x05color\x88\x01\x01\x12\x17\n" +
+	"\x04team\x18\t \x01(\rH\aR\x04team\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"velocity_x\x18\n" +
+	" \x01(\x01H\bR\tvelocityX\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"velocity_y\x18\v \x01(\x01H\tR\tvelocityY\x88\x01\x01\x12,\n" +
+	"\x0fspawn_protected\x18\f \x01(\bH\n" +
+	"R\x0espawnProtected\x88\x01\x01B\a\n" +
+	"\x05_nameB\x04\n" +
+	"\x02_xB\x04\n" +
+	"\x02_yB\t\n" +
+	"\a_radiusB\f\n" +
+	"\n" +
+	"_directionB\b\n" +
+	"\x06_speedB\b\n" +
+	"\x06_colorB\a\n" +
+	"\x05_teamB\r\n" +
+	"\v_velocity_xB\r\n" +
+	"\v_velocity_yB\x12\n" +
+	"\x10_spawn_protected\"l\n" +
+	"\vCellMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bowner_id\x18\x02 \x01(\x04R\aownerId\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x01R\x01y\x12\x16\n" +
+	"\x06radius\x18\x05 \x01(\x01R\x06radius\"\xda\x01\n" +
+	"\x0fSnapshotMessage\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x1a\n" +
+	"\bbaseline\x18\x02 \x01(\x04R\bbaseline\x125\n" +
+	"\aplayers\x18\x03 \x03(\v2\x1b.packets.PlayerDeltaMessageR\aplayers\x12,\n" +
+	"\x12removed_player_ids\x18\x04 \x03(\x04R\x10removedPlayerIds\x12*\n" +
+	"\x05cells\x18\x05 \x03(\v2\x14.packets.CellMessageR\x05cells\"0\n" +
+	"\x12SnapshotAckMessage\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\"g\n" +
+	"\vRoomMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aplayers\x18\x03 \x01(\rR\aplayers\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\rR\bcapacity\"\x18\n" +
+	"\x16RoomListRequestMessage\"=\n" +
+	"\x0fRoomListMessage\x12*\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x14.packets.RoomMessageR\x05rooms\"1\n" +
+	"\x16JoinRoomRequestMessage\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\"J\n" +
+	"\x18CreateRoomRequestMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\rR\bcapacity\"N\n" +
+	"\x12RoomCreatedMessage\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\x12\x1f\n" +
+	"\vinvite_code\x18\x02 \x01(\tR\n" +
+	"inviteCode\"?\n" +
+	"\x1cJoinRoomByCodeRequestMessage\x12\x1f\n" +
+	"\vinvite_code\x18\x01 \x01(\tR\n" +
+	"inviteCode\"7\n" +
+	"\x18KickPlayerRequestMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\"0\n" +
+	"\x16LockRoomRequestMessage\x12\x16\n" +
+	"\x06locked\x18\x01 \x01(\bR\x06locked\"\x19\n" +
+	"\x17CloseRoomRequestMessage\"0\n" +
+	"\x16RemovedFromRoomMessage\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"O\n" +
+	"\x0fGameOverMessage\x12\x1b\n" +
+	"\twinner_id\x18\x01 \x01(\x04R\bwinnerId\x12\x1f\n" +
+	"\vwinner_name\x18\x02 \x01(\tR\n" +
+	"winnerName\"H\n" +
+	"\x15TeamAssignmentMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x12\x12\n" +
+	"\x04team\x18\x02 \x01(\rR\x04team\"~\n" +
+	"\x10TeamScoreMessage\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\rR\x04team\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\rR\x05color\x12\x12\n" +
+	"\x04mass\x18\x04 \x01(\x04R\x04mass\x12\x18\n" +
+	"\aplayers\x18\x05 \x01(\rR\aplayers\"H\n" +
+	"\x15TeamScoreboardMessage\x12/\n" +
+	"\x05teams\x18\x01 \x03(\v2\x19.packets.TeamScoreMessageR\x05teams\":\n" +
+	"\x15RoundCountdownMessage\x12!\n" +
+	"\fseconds_left\x18\x01 \x01(\rR\vsecondsLeft\"m\n" +
+	"\x12RoundResultMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04mass\x18\x03 \x01(\x04R\x04mass\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\rR\x04rank\"H\n" +
+	"\x0fRoundEndMessage\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.packets.RoundResultMessageR\aresults\"h\n" +
+	"\x12WorldBoundsMessage\x12\x13\n" +
+	"\x05min_x\x18\x01 \x01(\x01R\x04minX\x12\x13\n" +
+	"\x05min_y\x18\x02 \x01(\x01R\x04minY\x12\x13\n" +
+	"\x05max_x\x18\x03 \x01(\x01R\x04maxX\x12\x13\n" +
+	"\x05max_y\x18\x04 \x01(\x01R\x04maxY\"f\n" +
+	"\vZoneMessage\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x01R\x01y\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\x12#\n" +
+	"\rtarget_radius\x18\x04 \x01(\x01R\ftargetRadius\"_\n" +
+	"\x0eGameMapMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\tobstacles\x18\x02 \x03(\v2\x1b.packets.WorldBoundsMessageR\tobstacles\"\xcd\x01\n" +
+	"\x13DeathSummaryMessage\x12\x1b\n" +
+	"\tkiller_id\x18\x01 \x01(\x04R\bkillerId\x12\x1f\n" +
+	"\vkiller_name\x18\x02 \x01(\tR\n" +
+	"killerName\x12\"\n" +
+	"\rtime_alive_ms\x18\x03 \x01(\x04R\vtimeAliveMs\x12\x1b\n" +
+	"\tpeak_mass\x18\x04 \x01(\x01R\bpeakMass\x12\x14\n" +
+	"\x05kills\x18\x05 \x01(\rR\x05kills\x12!\n" +
+	"\fspores_eaten\x18\x06 \x01(\rR\vsporesEaten\"\x17\n" +
+	"\x15RespawnRequestMessage\"5\n" +
+	"\x1aSpectateRoomRequestMessage\x12\x17\n" +
+	"\aroom_id\x18\x01 \x01(\x04R\x06roomId\"9\n" +
+	"\x1aFollowPlayerRequestMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\"?\n" +
+	"!CycleSpectateTargetRequestMessage\x12\x1a\n" +
+	"\bprevious\x18\x01 \x01(\bR\bprevious\"`\n" +
+	"\x15SpectateTargetMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x04R\bplayerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06leader\x18\x03 \x01(\bR\x06leader\"\x81\x1d\n" +
 	"\x06Packet\x12\x1b\n" +
 	"\tsender_id\x18\x01 \x01(\x04R\bsenderId\x12*\n" +
 	"\x04chat\x18\x02 \x01(\v2\x14.packets.ChatMessageH\x00R\x04chat\x12$\n" +
@@ -1332,8 +4487,53 @@ const file_packets_proto_rawDesc = "" +
 	"\x0esearch_hiscore\x18\x12 \x01(\v2\x1d.packets.SearchHiscoreMessageH\x00R\rsearchHiscore\x12<\n" +
 	"\n" +
 	"disconnect\x18\x13 \x01(\v2\x1a.packets.DisconnectMessageH\x00R\n" +
-	"disconnectB\x05\n" +
-	"\x03msgB\x1eZ\vpkg/packets\xaa\x02\x0eClient.Packetsb\x06proto3"
+	"disconnect\x12:\n" +
+	"\n" +
+	"view_enter\x18\x14 \x01(\v2\x19.packets.ViewEnterMessageH\x00R\tviewEnter\x12:\n" +
+	"\n" +
+	"view_leave\x18\x15 \x01(\v2\x19.packets.ViewLeaveMessageH\x00R\tviewLeave\x126\n" +
+	"\bsnapshot\x18\x16 \x01(\v2\x18.packets.SnapshotMessageH\x00R\bsnapshot\x12@\n" +
+	"\fsnapshot_ack\x18\x17 \x01(\v2\x1b.packets.SnapshotAckMessageH\x00R\vsnapshotAck\x12M\n" +
+	"\x11room_list_request\x18\x18 \x01(\v2\x1f.packets.RoomListRequestMessageH\x00R\x0froomListRequest\x127\n" +
+	"\troom_list\x18\x19 \x01(\v2\x18.packets.RoomListMessageH\x00R\broomList\x12M\n" +
+	"\x11join_room_request\x18\x1a \x01(\v2\x1f.packets.JoinRoomRequestMessageH\x00R\x0fjoinRoomRequest\x12S\n" +
+	"\x13create_room_request\x18\x1b \x01(\v2!.packets.CreateRoomRequestMessageH\x00R\x11createRoomRequest\x12@\n" +
+	"\froom_created\x18\x1c \x01(\v2\x1b.packets.RoomCreatedMessageH\x00R\vroomCreated\x12a\n" +
+	"\x19join_room_by_code_request\x18\x1d \x01(\v2%.packets.JoinRoomByCodeRequestMessageH\x00R\x15joinRoomByCodeRequest\x12S\n" +
+	"\x13kick_player_request\x18\x1e \x01(\v2!.packets.KickPlayerRequestMessageH\x00R\x11kickPlayerRequest\x12M\n" +
+	"\x11lock_room_request\x18\x1f \x01(\v2\x1f.packets.LockRoomRequestMessageH\x00R\x0flockRoomRequest\x12P\n" +
+	"\x12close_room_request\x18  \x01(\v2 .packets.CloseRoomRequestMessageH\x00R\x10closeRoomRequest\x12M\n" +
+	"\x11removed_from_room\x18! \x01(\v2\x1f.packets.RemovedFromRoomMessageH\x00R\x0fremovedFromRoom\x127\n" +
+	"\tgame_over\x18\" \x01(\v2\x18.packets.GameOverMessageH\x00R\bgameOver\x12I\n" +
+	"\x0fteam_assignment\x18# \x01(\v2\x1e.packets.TeamAssignmentMessageH\x00R\x0eteamAssignment\x12I\n" +
+	"\x0fteam_scoreboard\x18$ \x01(\v2\x1e.packets.TeamScoreboardMessageH\x00R\x0eteamScoreboard\x12I\n" +
+	"\x0fround_countdown\x18% \x01(\v2\x1e.packets.RoundCountdownMessageH\x00R\x0eroundCountdown\x127\n" +
+	"\tround_end\x18& \x01(\v2\x18.packets.RoundEndMessageH\x00R\broundEnd\x12*\n" +
+	"\x04zone\x18' \x01(\v2\x14.packets.ZoneMessageH\x00R\x04zone\x12@\n" +
+	"\fworld_bounds\x18( \x01(\v2\x1b.packets.WorldBoundsMessageH\x00R\vworldBounds\x12@\n" +
+	"\fplayer_split\x18) \x01(\v2\x1b.packets.PlayerSplitMessageH\x00R\vplayerSplit\x12@\n" +
+	"\fplayer_eject\x18* \x01(\v2\x1b.packets.PlayerEjectMessageH\x00R\vplayerEject\x12C\n" +
+	"\rplayer_target\x18+ \x01(\v2\x1c.packets.PlayerTargetMessageH\x00R\fplayerTarget\x12@\n" +
+	"\fhazard_burst\x18, \x01(\v2\x1b.packets.HazardBurstMessageH\x00R\vhazardBurst\x12P\n" +
+	"\x12power_up_collected\x18- \x01(\v2 .packets.PowerUpCollectedMessageH\x00R\x10powerUpCollected\x12J\n" +
+	"\x10power_up_expired\x18. \x01(\v2\x1e.packets.PowerUpExpiredMessageH\x00R\x0epowerUpExpired\x124\n" +
+	"\bgame_map\x18/ \x01(\v2\x17.packets.GameMapMessageH\x00R\agameMap\x12C\n" +
+	"\rdeath_summary\x180 \x01(\v2\x1c.packets.DeathSummaryMessageH\x00R\fdeathSummary\x12I\n" +
+	"\x0frespawn_request\x181 \x01(\v2\x1e.packets.RespawnRequestMessageH\x00R\x0erespawnRequest\x12Y\n" +
+	"\x15spectate_room_request\x182 \x01(\v2#.packets.SpectateRoomRequestMessageH\x00R\x13spectateRoomRequest\x12Y\n" +
+	"\x15follow_player_request\x183 \x01(\v2#.packets.FollowPlayerRequestMessageH\x00R\x13followPlayerRequest\x12o\n" +
+	"\x1dcycle_spectate_target_request\x184 \x01(\v2*.packets.CycleSpectateTargetRequestMessageH\x00R\x1acycleSpectateTargetRequest\x12I\n" +
+	"\x0fspectate_target\x185 \x01(\v2\x1e.packets.SpectateTargetMessageH\x00R\x0espectateTargetB\x05\n" +
+	"\x03msg*A\n" +
+	"\tSporeKind\x12\x10\n" +
+	"\fSPORE_COMMON\x10\x00\x12\x10\n" +
+	"\fSPORE_GOLDEN\x10\x01\x12\x10\n" +
+	"\fSPORE_POISON\x10\x02*^\n" +
+	"\vPowerUpKind\x12\x11\n" +
+	"\rPOWER_UP_NONE\x10\x00\x12\x12\n" +
+	"\x0ePOWER_UP_SPEED\x10\x01\x12\x13\n" +
+	"\x0fPOWER_UP_SHIELD\x10\x02\x12\x13\n" +
+	"\x0fPOWER_UP_MAGNET\x10\x03B\x1eZ\vpkg/packets\xaa\x02\x0eClient.Packetsb\x06proto3"
 
 var (
 	file_packets_proto_rawDescOnce sync.Once
@@ -1347,54 +4547,145 @@ func file_packets_proto_rawDescGZIP() []byte {
 	return file_packets_proto_rawDescData
 }
 
-var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_packets_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_packets_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_packets_proto_goTypes = []any{
-	(*ChatMessage)(nil),                     // 0: packets.ChatMessage
-	(*IdMessage)(nil),                       // 1: packets.IdMessage
-	(*LoginRequestMessage)(nil),             // 2: packets.LoginRequestMessage
-	(*RegisterRequestMessage)(nil),          // 3: packets.RegisterRequestMessage
-	(*OkResponseMessage)(nil),               // 4: packets.OkResponseMessage
-	(*DenyResponseMessage)(nil),             // 5: packets.DenyResponseMessage
-	(*PlayerMessage)(nil),                   // 6: packets.PlayerMessage
-	(*PlayerDirectionMessage)(nil),          // 7: packets.PlayerDirectionMessage
-	(*SporeMessage)(nil),                    // 8: packets.SporeMessage
-	(*SporeConsumedMessage)(nil),            // 9: packets.SporeConsumedMessage
-	(*SporeBatchMessage)(nil),               // 10: packets.SporeBatchMessage
-	(*PlayerConsumedMessage)(nil),           // 11: packets.PlayerConsumedMessage
-	(*HiscoreBoardRequestMessage)(nil),      // 12: packets.HiscoreBoardRequestMessage
-	(*HiscoreMessage)(nil),                  // 13: packets.HiscoreMessage
-	(*HiscoreBoardMessage)(nil),             // 14: packets.HiscoreBoardMessage
-	(*FinishedBrowsingHiscoresMessage)(nil), // 15: packets.FinishedBrowsingHiscoresMessage
-	(*SearchHiscoreMessage)(nil),            // 16: packets.SearchHiscoreMessage
-	(*DisconnectMessage)(nil),               // 17: packets.DisconnectMessage
-	(*Packet)(nil),                          // 18: packets.Packet
+	(SporeKind)(0),                            // 0: packets.SporeKind
+	(PowerUpKind)(0),                          // 1: packets.PowerUpKind
+	(*ChatMessage)(nil),                       // 2: packets.ChatMessage
+	(*IdMessage)(nil),                         // 3: packets.IdMessage
+	(*LoginRequestMessage)(nil),               // 4: packets.LoginRequestMessage
+	(*RegisterRequestMessage)(nil),            // 5: packets.RegisterRequestMessage
+	(*OkResponseMessage)(nil),                 // 6: packets.OkResponseMessage
+	(*DenyResponseMessage)(nil),               // 7: packets.DenyResponseMessage
+	(*PlayerMessage)(nil),                     // 8: packets.PlayerMessage
+	(*PlayerDirectionMessage)(nil),            // 9: packets.PlayerDirectionMessage
+	(*PlayerTargetMessage)(nil),               // 10: packets.PlayerTargetMessage
+	(*PlayerSplitMessage)(nil),                // 11: packets.PlayerSplitMessage
+	(*PlayerEjectMessage)(nil),                // 12: packets.PlayerEjectMessage
+	(*SporeMessage)(nil),                      // 13: packets.SporeMessage
+	(*SporeConsumedMessage)(nil),              // 14: packets.SporeConsumedMessage
+	(*SporeBatchMessage)(nil),                 // 15: packets.SporeBatchMessage
+	(*PlayerConsumedMessage)(nil),             // 16: packets.PlayerConsumedMessage
+	(*HiscoreBoardRequestMessage)(nil),        // 17: packets.HiscoreBoardRequestMessage
+	(*HiscoreMessage)(nil),                    // 18: packets.HiscoreMessage
+	(*HiscoreBoardMessage)(nil),               // 19: packets.HiscoreBoardMessage
+	(*FinishedBrowsingHiscoresMessage)(nil),   // 20: packets.FinishedBrowsingHiscoresMessage
+	(*SearchHiscoreMessage)(nil),              // 21: packets.SearchHiscoreMessage
+	(*DisconnectMessage)(nil),                 // 22: packets.DisconnectMessage
+	(*ViewEnterMessage)(nil),                  // 23: packets.ViewEnterMessage
+	(*ViewLeaveMessage)(nil),                  // 24: packets.ViewLeaveMessage
+	(*HazardMessage)(nil),                     // 25: packets.HazardMessage
+	(*HazardBurstMessage)(nil),                // 26: packets.HazardBurstMessage
+	(*PowerUpMessage)(nil),                    // 27: packets.PowerUpMessage
+	(*PowerUpCollectedMessage)(nil),           // 28: packets.PowerUpCollectedMessage
+	(*PowerUpExpiredMessage)(nil),             // 29: packets.PowerUpExpiredMessage
+	(*PlayerDeltaMessage)(nil),                // 30: packets.PlayerDeltaMessage
+	(*CellMessage)(nil),                       // 31: packets.CellMessage
+	(*SnapshotMessage)(nil),                   // 32: packets.SnapshotMessage
+	(*SnapshotAckMessage)(nil),                // 33: packets.SnapshotAckMessage
+	(*RoomMessage)(nil),                       // 34: packets.RoomMessage
+	(*RoomListRequestMessage)(nil),            // 35: packets.RoomListRequestMessage
+	(*RoomListMessage)(nil),                   // 36: packets.RoomListMessage
+	(*JoinRoomRequestMessage)(nil),            // 37: packets.JoinRoomRequestMessage
+	(*CreateRoomRequestMessage)(nil),          // 38: packets.CreateRoomRequestMessage
+	(*RoomCreatedMessage)(nil),                // 39: packets.RoomCreatedMessage
+	(*JoinRoomByCodeRequestMessage)(nil),      // 40: packets.JoinRoomByCodeRequestMessage
+	(*KickPlayerRequestMessage)(nil),          // 41: packets.KickPlayerRequestMessage
+	(*LockRoomRequestMessage)(nil),            // 42: packets.LockRoomRequestMessage
+	(*CloseRoomRequestMessage)(nil),           // 43: packets.CloseRoomRequestMessage
+	(*RemovedFromRoomMessage)(nil),            // 44: packets.RemovedFromRoomMessage
+	(*GameOverMessage)(nil),                   // 45: packets.GameOverMessage
+	(*TeamAssignmentMessage)(nil),             // 46: packets.TeamAssignmentMessage
+	(*TeamScoreMessage)(nil),                  // 47: packets.TeamScoreMessage
+	(*TeamScoreboardMessage)(nil),             // 48: packets.TeamScoreboardMessage
+	(*RoundCountdownMessage)(nil),             // 49: packets.RoundCountdownMessage
+	(*RoundResultMessage)(nil),                // 50: packets.RoundResultMessage
+	(*RoundEndMessage)(nil),                   // 51: packets.RoundEndMessage
+	(*WorldBoundsMessage)(nil),                // 52: packets.WorldBoundsMessage
+	(*ZoneMessage)(nil),                       // 53: packets.ZoneMessage
+	(*GameMapMessage)(nil),                    // 54: packets.GameMapMessage
+	(*DeathSummaryMessage)(nil),               // 55: packets.DeathSummaryMessage
+	(*RespawnRequestMessage)(nil),             // 56: packets.RespawnRequestMessage
+	(*SpectateRoomRequestMessage)(nil),        // 57: packets.SpectateRoomRequestMessage
+	(*FollowPlayerRequestMessage)(nil),        // 58: packets.FollowPlayerRequestMessage
+	(*CycleSpectateTargetRequestMessage)(nil), // 59: packets.CycleSpectateTargetRequestMessage
+	(*SpectateTargetMessage)(nil),             // 60: packets.SpectateTargetMessage
+	(*Packet)(nil),                            // 61: packets.Packet
 }
 var file_packets_proto_depIdxs = []int32{
-	8,  // 0: packets.SporeBatchMessage.spores:type_name -> packets.SporeMessage
-	13, // 1: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
-	0,  // 2: packets.Packet.chat:type_name -> packets.ChatMessage
-	1,  // 3: packets.Packet.id:type_name -> packets.IdMessage
-	2,  // 4: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
-	3,  // 5: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
-	4,  // 6: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
-	5,  // 7: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
-	6,  // 8: packets.Packet.player:type_name -> packets.PlayerMessage
-	7,  // 9: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
-	8,  // 10: packets.Packet.spore:type_name -> packets.SporeMessage
-	9,  // 11: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
-	10, // 12: packets.Packet.spore_batch:type_name -> packets.SporeBatchMessage
-	11, // 13: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
-	12, // 14: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
-	13, // 15: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
-	14, // 16: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
-	15, // 17: packets.Packet.finish_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
-	16, // 18: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
-	17, // 19: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	0,  // 0: packets.SporeMessage.kind:type_name -> packets.SporeKind
+	13, // 1: packets.SporeBatchMessage.spores:type_name -> packets.SporeMessage
+	18, // 2: packets.HiscoreBoardMessage.hiscores:type_name -> packets.HiscoreMessage
+	13, // 3: packets.ViewEnterMessage.spores:type_name -> packets.SporeMessage
+	25, // 4: packets.ViewEnterMessage.hazards:type_name -> packets.HazardMessage
+	27, // 5: packets.ViewEnterMessage.power_ups:type_name -> packets.PowerUpMessage
+	1,  // 6: packets.PowerUpMessage.kind:type_name -> packets.PowerUpKind
+	1,  // 7: packets.PowerUpCollectedMessage.kind:type_name -> packets.PowerUpKind
+	1,  // 8: packets.PowerUpExpiredMessage.kind:type_name -> packets.PowerUpKind
+	30, // 9: packets.SnapshotMessage.players:type_name -> packets.PlayerDeltaMessage
+	31, // 10: packets.SnapshotMessage.cells:type_name -> packets.CellMessage
+	34, // 11: packets.RoomListMessage.rooms:type_name -> packets.RoomMessage
+	47, // 12: packets.TeamScoreboardMessage.teams:type_name -> packets.TeamScoreMessage
+	50, // 13: packets.RoundEndMessage.results:type_name -> packets.RoundResultMessage
+	52, // 14: packets.GameMapMessage.obstacles:type_name -> packets.WorldBoundsMessage
+	2,  // 15: packets.Packet.chat:type_name -> packets.ChatMessage
+	3,  // 16: packets.Packet.id:type_name -> packets.IdMessage
+	4,  // 17: packets.Packet.login_request:type_name -> packets.LoginRequestMessage
+	5,  // 18: packets.Packet.register_request:type_name -> packets.RegisterRequestMessage
+	6,  // 19: packets.Packet.ok_response:type_name -> packets.OkResponseMessage
+	7,  // 20: packets.Packet.deny_response:type_name -> packets.DenyResponseMessage
+	8,  // 21: packets.Packet.player:type_name -> packets.PlayerMessage
+	9,  // 22: packets.Packet.player_direction:type_name -> packets.PlayerDirectionMessage
+	13, // 23: packets.Packet.spore:type_name -> packets.SporeMessage
+	14, // 24: packets.Packet.spore_consumed:type_name -> packets.SporeConsumedMessage
+	15, // 25: packets.Packet.spore_batch:type_name -> packets.SporeBatchMessage
+	16, // 26: packets.Packet.player_consumed:type_name -> packets.PlayerConsumedMessage
+	17, // 27: packets.Packet.hiscore_board_request:type_name -> packets.HiscoreBoardRequestMessage
+	18, // 28: packets.Packet.hiscore:type_name -> packets.HiscoreMessage
+	19, // 29: packets.Packet.hiscore_board:type_name -> packets.HiscoreBoardMessage
+	20, // 30: packets.Packet.finish_browsing_hiscores:type_name -> packets.FinishedBrowsingHiscoresMessage
+	21, // 31: packets.Packet.search_hiscore:type_name -> packets.SearchHiscoreMessage
+	22, // 32: packets.Packet.disconnect:type_name -> packets.DisconnectMessage
+	23, // 33: packets.Packet.view_enter:type_name -> packets.ViewEnterMessage
+	24, // 34: packets.Packet.view_leave:type_name -> packets.ViewLeaveMessage
+	32, // 35: packets.Packet.snapshot:type_name -> packets.SnapshotMessage
+	33, // 36: packets.Packet.snapshot_ack:type_name -> packets.SnapshotAckMessage
+	35, // 37: packets.Packet.room_list_request:type_name -> packets.RoomListRequestMessage
+	36, // 38: packets.Packet.room_list:type_name -> packets.RoomListMessage
+	37, // 39: packets.Packet.join_room_request:type_name -> packets.JoinRoomRequestMessage
+	38, // 40: packets.Packet.create_room_request:type_name -> packets.CreateRoomRequestMessage
+	39, // 41: packets.Packet.room_created:type_name -> packets.RoomCreatedMessage
+	40, // 42: packets.Packet.join_room_by_code_request:type_name -> packets.JoinRoomByCodeRequestMessage
+	41, // 43: packets.Packet.kick_player_request:type_name -> packets.KickPlayerRequestMessage
+	42, // 44: packets.Packet.lock_room_request:type_name -> packets.LockRoomRequestMessage
+	43, // 45: packets.Packet.close_room_request:type_name -> packets.CloseRoomRequestMessage
+	44, // 46: packets.Packet.removed_from_room:type_name -> packets.RemovedFromRoomMessage
+	45, // 47: packets.Packet.game_over:type_name -> packets.GameOverMessage
+	46, // 48: packets.Packet.team_assignment:type_name -> packets.TeamAssignmentMessage
+	48, // 49: packets.Packet.team_scoreboard:type_name -> packets.TeamScoreboardMessage
+	49, // 50: packets.Packet.round_countdown:type_name -> packets.RoundCountdownMessage
+	51, // 51: packets.Packet.round_end:type_name -> packets.RoundEndMessage
+	53, // 52: packets.Packet.zone:type_name -> packets.ZoneMessage
+	52, // 53: packets.Packet.world_bounds:type_name -> packets.WorldBoundsMessage
+	11, // 54: packets.Packet.player_split:type_name -> packets.PlayerSplitMessage
+	12, // 55: packets.Packet.player_eject:type_name -> packets.PlayerEjectMessage
+	10, // 56: packets.Packet.player_target:type_name -> packets.PlayerTargetMessage
+	26, // 57: packets.Packet.hazard_burst:type_name -> packets.HazardBurstMessage
+	28, // 58: packets.Packet.power_up_collected:type_name -> packets.PowerUpCollectedMessage
+	29, // 59: packets.Packet.power_up_expired:type_name -> packets.PowerUpExpiredMessage
+	54, // 60: packets.Packet.game_map:type_name -> packets.GameMapMessage
+	55, // 61: packets.Packet.death_summary:type_name -> packets.DeathSummaryMessage
+	56, // 62: packets.Packet.respawn_request:type_name -> packets.RespawnRequestMessage
+	57, // 63: packets.Packet.spectate_room_request:type_name -> packets.SpectateRoomRequestMessage
+	58, // 64: packets.Packet.follow_player_request:type_name -> packets.FollowPlayerRequestMessage
+	59, // 65: packets.Packet.cycle_spectate_target_request:type_name -> packets.CycleSpectateTargetRequestMessage
+	60, // 66: packets.Packet.spectate_target:type_name -> packets.SpectateTargetMessage
+	67, // [67:67] is the sub-list for method output_type
+	67, // [67:67] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_packets_proto_init() }
@@ -1402,7 +4693,8 @@ func file_packets_proto_init() {
 	if File_packets_proto != nil {
 		return
 	}
-	file_packets_proto_msgTypes[18].OneofWrappers = []any{
+	file_packets_proto_msgTypes[28].OneofWrappers = []any{}
+	file_packets_proto_msgTypes[59].OneofWrappers = []any{
 		(*Packet_Chat)(nil),
 		(*Packet_Id)(nil),
 		(*Packet_LoginRequest)(nil),
@@ -1421,19 +4713,54 @@ func file_packets_proto_init() {
 		(*Packet_FinishBrowsingHiscores)(nil),
 		(*Packet_SearchHiscore)(nil),
 		(*Packet_Disconnect)(nil),
+		(*Packet_ViewEnter)(nil),
+		(*Packet_ViewLeave)(nil),
+		(*Packet_Snapshot)(nil),
+		(*Packet_SnapshotAck)(nil),
+		(*Packet_RoomListRequest)(nil),
+		(*Packet_RoomList)(nil),
+		(*Packet_JoinRoomRequest)(nil),
+		(*Packet_CreateRoomRequest)(nil),
+		(*Packet_RoomCreated)(nil),
+		(*Packet_JoinRoomByCodeRequest)(nil),
+		(*Packet_KickPlayerRequest)(nil),
+		(*Packet_LockRoomRequest)(nil),
+		(*Packet_CloseRoomRequest)(nil),
+		(*Packet_RemovedFromRoom)(nil),
+		(*Packet_GameOver)(nil),
+		(*Packet_TeamAssignment)(nil),
+		(*Packet_TeamScoreboard)(nil),
+		(*Packet_RoundCountdown)(nil),
+		(*Packet_RoundEnd)(nil),
+		(*Packet_Zone)(nil),
+		(*Packet_WorldBounds)(nil),
+		(*Packet_PlayerSplit)(nil),
+		(*Packet_PlayerEject)(nil),
+		(*Packet_PlayerTarget)(nil),
+		(*Packet_HazardBurst)(nil),
+		(*Packet_PowerUpCollected)(nil),
+		(*Packet_PowerUpExpired)(nil),
+		(*Packet_GameMap)(nil),
+		(*Packet_DeathSummary)(nil),
+		(*Packet_RespawnRequest)(nil),
+		(*Packet_SpectateRoomRequest)(nil),
+		(*Packet_FollowPlayerRequest)(nil),
+		(*Packet_CycleSpectateTargetRequest)(nil),
+		(*Packet_SpectateTarget)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_packets_proto_rawDesc), len(file_packets_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      2,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_packets_proto_goTypes,
 		DependencyIndexes: file_packets_proto_depIdxs,
+		EnumInfos:         file_packets_proto_enumTypes,
 		MessageInfos:      file_packets_proto_msgTypes,
 	}.Build()
 	File_packets_proto = out.File
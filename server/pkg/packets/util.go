@@ -2,6 +2,7 @@ package packets
 
 import (
 	"server/internal/server/objects"
+	"time"
 )
 
 type Msg = isPacket_Msg
@@ -47,16 +48,25 @@ func NewLoginRequest(username, password string) Msg {
 
 func NewPlayer(id uint64, player *objects.Player) Msg {
 	return &Packet_Player{
-		Player: &PlayerMessage{
-			Id:        	id,
-			Name:      	player.Name,
-			X:         	player.X,
-			Y:         	player.Y,
-			Radius:    	player.Radius,
-			Direction: 	player.Direction,
-			Speed:     	player.Speed,
-			Color:			player.Color,
-		},
+		Player: newPlayerMessage(id, player),
+	}
+}
+
+func newPlayerMessage(id uint64, player *objects.Player) *PlayerMessage {
+	return &PlayerMessage{
+		Id:        id,
+		Name:      player.Name,
+		X:         player.X,
+		Y:         player.Y,
+		Radius:    player.Radius,
+		Direction: player.Direction,
+		Speed:     player.Speed,
+		Color:     player.Color,
+		Team:      player.Team,
+		VelocityX: player.VelocityX,
+		VelocityY: player.VelocityY,
+
+		SpawnProtected: player.SpawnProtected,
 	}
 }
 
@@ -72,11 +82,51 @@ func newSporeMessage(spore_id uint64, spore *objects.Spore) *SporeMessage {
 			X:      spore.X,
 			Y:      spore.Y,
 			Radius: spore.Radius,
+			Kind:   SporeKind(spore.Kind),
 		}
 }
 
+func NewSporeConsumed(sporeId uint64) Msg {
+	return &Packet_SporeConsumed{
+		SporeConsumed: &SporeConsumedMessage{
+			SporeId: sporeId,
+		},
+	}
+}
+
+func NewPlayerConsumed(playerId uint64) Msg {
+	return &Packet_PlayerConsumed{
+		PlayerConsumed: &PlayerConsumedMessage{
+			PlayerId: playerId,
+		},
+	}
+}
+
+func NewDeathSummary(player *objects.Player) Msg {
+	return &Packet_DeathSummary{
+		DeathSummary: &DeathSummaryMessage{
+			KillerId:    player.KillerId,
+			KillerName:  player.KillerName,
+			TimeAliveMs: uint64(time.Since(player.SpawnedAt).Milliseconds()),
+			PeakMass:    player.PeakMass,
+			Kills:       player.Kills,
+			SporesEaten: player.SporesEaten,
+		},
+	}
+}
+
+func NewSpectateTarget(playerId uint64, name string, leader bool) Msg {
+	return &Packet_SpectateTarget{
+		SpectateTarget: &SpectateTargetMessage{
+			PlayerId: playerId,
+			Name:     name,
+			Leader:   leader,
+		},
+	}
+}
+
 func NewSporesBatch(spores map[uint64]*objects.Spore) Msg {
-	sporesMessages := make([]*SporeMessage, len(spores))
+	sporesMessages := make([]*SporeMessage, 0, len(spores))
 	for id, spore := range spores {
 		sporesMessages = append(sporesMessages, newSporeMessage(id, spore))
 	}
@@ -88,6 +138,293 @@ func NewSporesBatch(spores map[uint64]*objects.Spore) Msg {
 	}
 }
 
+func NewViewEnter(spores map[uint64]*objects.Spore, hazards map[uint64]*objects.Hazard, powerUps map[uint64]*objects.PowerUp) Msg {
+	sporeMessages := make([]*SporeMessage, 0, len(spores))
+	for id, spore := range spores {
+		sporeMessages = append(sporeMessages, newSporeMessage(id, spore))
+	}
+
+	hazardMessages := make([]*HazardMessage, 0, len(hazards))
+	for id, hazard := range hazards {
+		hazardMessages = append(hazardMessages, &HazardMessage{
+			Id:     id,
+			X:      hazard.X,
+			Y:      hazard.Y,
+			Radius: hazard.Radius,
+		})
+	}
+
+	powerUpMessages := make([]*PowerUpMessage, 0, len(powerUps))
+	for id, powerUp := range powerUps {
+		powerUpMessages = append(powerUpMessages, &PowerUpMessage{
+			Id:     id,
+			X:      powerUp.X,
+			Y:      powerUp.Y,
+			Radius: powerUp.Radius,
+			Kind:   PowerUpKind(powerUp.Kind),
+		})
+	}
+
+	return &Packet_ViewEnter{
+		ViewEnter: &ViewEnterMessage{
+			Spores:   sporeMessages,
+			Hazards:  hazardMessages,
+			PowerUps: powerUpMessages,
+		},
+	}
+}
+
+func NewViewLeave(sporeIds []uint64, hazardIds []uint64, powerUpIds []uint64) Msg {
+	return &Packet_ViewLeave{
+		ViewLeave: &ViewLeaveMessage{
+			SporeIds:   sporeIds,
+			HazardIds:  hazardIds,
+			PowerUpIds: powerUpIds,
+		},
+	}
+}
+
+func NewPowerUpCollected(powerUpId, playerId uint64, kind objects.PowerUpKind, duration time.Duration) Msg {
+	return &Packet_PowerUpCollected{
+		PowerUpCollected: &PowerUpCollectedMessage{
+			PowerUpId:  powerUpId,
+			PlayerId:   playerId,
+			Kind:       PowerUpKind(kind),
+			DurationMs: uint32(duration.Milliseconds()),
+		},
+	}
+}
+
+func NewPowerUpExpired(playerId uint64, kind objects.PowerUpKind) Msg {
+	return &Packet_PowerUpExpired{
+		PowerUpExpired: &PowerUpExpiredMessage{
+			PlayerId: playerId,
+			Kind:     PowerUpKind(kind),
+		},
+	}
+}
+
+func NewHazardBurst(hazardId, playerId uint64) Msg {
+	return &Packet_HazardBurst{
+		HazardBurst: &HazardBurstMessage{
+			HazardId: hazardId,
+			PlayerId: playerId,
+		},
+	}
+}
+
+// Get the fields of the player that differ from the baseline, or nil if nothing changed. A nil baseline means every field is sent.
+func NewPlayerDelta(id uint64, player *objects.Player, baseline *objects.Player) *PlayerDeltaMessage {
+	if baseline == nil {
+		return &PlayerDeltaMessage{
+			Id:        id,
+			Name:      &player.Name,
+			X:         &player.X,
+			Y:         &player.Y,
+			Radius:    &player.Radius,
+			Direction: &player.Direction,
+			Speed:     &player.Speed,
+			Color:     &player.Color,
+			Team:      &player.Team,
+			VelocityX: &player.VelocityX,
+			VelocityY: &player.VelocityY,
+
+			SpawnProtected: &player.SpawnProtected,
+		}
+	}
+
+	delta := &PlayerDeltaMessage{Id: id}
+	changed := false
+	if player.Name != baseline.Name {
+		delta.Name, changed = &player.Name, true
+	}
+	if player.X != baseline.X {
+		delta.X, changed = &player.X, true
+	}
+	if player.Y != baseline.Y {
+		delta.Y, changed = &player.Y, true
+	}
+	if player.Radius != baseline.Radius {
+		delta.Radius, changed = &player.Radius, true
+	}
+	if player.Direction != baseline.Direction {
+		delta.Direction, changed = &player.Direction, true
+	}
+	if player.Speed != baseline.Speed {
+		delta.Speed, changed = &player.Speed, true
+	}
+	if player.Color != baseline.Color {
+		delta.Color, changed = &player.Color, true
+	}
+	if player.Team != baseline.Team {
+		delta.Team, changed = &player.Team, true
+	}
+	if player.VelocityX != baseline.VelocityX {
+		delta.VelocityX, changed = &player.VelocityX, true
+	}
+	if player.VelocityY != baseline.VelocityY {
+		delta.VelocityY, changed = &player.VelocityY, true
+	}
+	if player.SpawnProtected != baseline.SpawnProtected {
+		delta.SpawnProtected, changed = &player.SpawnProtected, true
+	}
+
+	if !changed {
+		return nil
+	}
+	return delta
+}
+
+func NewSnapshot(sequence, baseline uint64, players []*PlayerDeltaMessage, removedPlayerIds []uint64, cells []*CellMessage) Msg {
+	return &Packet_Snapshot{
+		Snapshot: &SnapshotMessage{
+			Sequence:         sequence,
+			Baseline:         baseline,
+			Players:          players,
+			RemovedPlayerIds: removedPlayerIds,
+			Cells:            cells,
+		},
+	}
+}
+
+func NewCell(cellId uint64, cell *objects.Cell) *CellMessage {
+	return &CellMessage{
+		Id:      cellId,
+		OwnerId: cell.OwnerId,
+		X:       cell.X,
+		Y:       cell.Y,
+		Radius:  cell.Radius,
+	}
+}
+
+func NewPlayerTarget(x, y float64) Msg {
+	return &Packet_PlayerTarget{
+		PlayerTarget: &PlayerTargetMessage{
+			X: x,
+			Y: y,
+		},
+	}
+}
+
+func NewPlayerSplit() Msg {
+	return &Packet_PlayerSplit{
+		PlayerSplit: &PlayerSplitMessage{},
+	}
+}
+
+func NewPlayerEject() Msg {
+	return &Packet_PlayerEject{
+		PlayerEject: &PlayerEjectMessage{},
+	}
+}
+
+func NewRoomList(rooms []*RoomMessage) Msg {
+	return &Packet_RoomList{
+		RoomList: &RoomListMessage{
+			Rooms: rooms,
+		},
+	}
+}
+
+func NewRoomCreated(roomId uint64, inviteCode string) Msg {
+	return &Packet_RoomCreated{
+		RoomCreated: &RoomCreatedMessage{
+			RoomId:     roomId,
+			InviteCode: inviteCode,
+		},
+	}
+}
+
+func NewRemovedFromRoom(reason string) Msg {
+	return &Packet_RemovedFromRoom{
+		RemovedFromRoom: &RemovedFromRoomMessage{
+			Reason: reason,
+		},
+	}
+}
+
+func NewGameOver(winnerId uint64, winnerName string) Msg {
+	return &Packet_GameOver{
+		GameOver: &GameOverMessage{
+			WinnerId:   winnerId,
+			WinnerName: winnerName,
+		},
+	}
+}
+
+func NewTeamAssignment(playerId uint64, team uint32) Msg {
+	return &Packet_TeamAssignment{
+		TeamAssignment: &TeamAssignmentMessage{
+			PlayerId: playerId,
+			Team:     team,
+		},
+	}
+}
+
+func NewTeamScoreboard(teams []*TeamScoreMessage) Msg {
+	return &Packet_TeamScoreboard{
+		TeamScoreboard: &TeamScoreboardMessage{
+			Teams: teams,
+		},
+	}
+}
+
+func NewRoundCountdown(secondsLeft uint32) Msg {
+	return &Packet_RoundCountdown{
+		RoundCountdown: &RoundCountdownMessage{
+			SecondsLeft: secondsLeft,
+		},
+	}
+}
+
+func NewRoundEnd(results []*RoundResultMessage) Msg {
+	return &Packet_RoundEnd{
+		RoundEnd: &RoundEndMessage{
+			Results: results,
+		},
+	}
+}
+
+func NewWorldBounds(bounds objects.Bounds) Msg {
+	return &Packet_WorldBounds{
+		WorldBounds: newWorldBoundsMessage(bounds),
+	}
+}
+
+func newWorldBoundsMessage(bounds objects.Bounds) *WorldBoundsMessage {
+	return &WorldBoundsMessage{
+		MinX: bounds.MinX,
+		MinY: bounds.MinY,
+		MaxX: bounds.MaxX,
+		MaxY: bounds.MaxY,
+	}
+}
+
+func NewGameMap(gameMap *objects.Map) Msg {
+	obstacles := make([]*WorldBoundsMessage, 0, len(gameMap.Obstacles))
+	for _, obstacle := range gameMap.Obstacles {
+		obstacles = append(obstacles, newWorldBoundsMessage(obstacle))
+	}
+
+	return &Packet_GameMap{
+		GameMap: &GameMapMessage{
+			Name:      gameMap.Name,
+			Obstacles: obstacles,
+		},
+	}
+}
+
+func NewZone(x, y, radius, targetRadius float64) Msg {
+	return &Packet_Zone{
+		Zone: &ZoneMessage{
+			X:            x,
+			Y:            y,
+			Radius:       radius,
+			TargetRadius: targetRadius,
+		},
+	}
+}
+
 func NewHiscoreBoard(hiscores []*HiscoreMessage) Msg {
 	return &Packet_HiscoreBoard{
 		HiscoreBoard: &HiscoreBoardMessage{